@@ -1,4 +1,3 @@
-
 /*
 Copyright (c) 2019 Cisco and/or its affiliates.
 
@@ -20,15 +19,261 @@ or implied.
 package main
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
-	Username string
-	Password string
-	Base_url string
+	Username           string
+	Password           string
+	ApiKey             string
+	Base_url           string
+	CACertFile         string
+	CACertPEM          string
+	InsecureSkipVerify bool
+	ClientCertFile     string
+	ClientKeyFile      string
+	ProxyURL           string
+	RequestTimeout     int
+	MaxRetries         int
+	DefaultTenantId    string
+	TenantName         string
+}
+
+// validate checks that exactly one of a password or an API key has been
+// provided, and that the username is either a plain username or one
+// qualified with a tenant name as <tenant_name>/<username>.
+func (c *Config) validate() error {
+
+	if c.Password == "" && c.ApiKey == "" {
+		return errors.New("ONE OF password OR api_key MUST BE SET")
+	}
+
+	if c.Password != "" && c.ApiKey != "" {
+		return errors.New("password AND api_key CANNOT BOTH BE SET")
+	}
+
+	if c.Base_url == "" {
+		return errors.New("base_url MUST BE SET, ON THE PROVIDER OR IN A CREDENTIALS PROFILE")
+	}
+
+	if c.Username == "" {
+		return errors.New("username MUST BE SET")
+	}
+
+	if strings.Contains(c.Username, "/") {
+
+		parts := strings.Split(c.Username, "/")
+
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return errors.New("username MUST BE IN THE FORMAT <username> OR <tenant_name>/<username>, GOT: " + c.Username)
+		}
+	}
+
+	if c.CACertFile != "" && c.CACertPEM != "" {
+		return errors.New("ca_cert_file AND ca_cert_pem CANNOT BOTH BE SET")
+	}
+
+	if (c.ClientCertFile == "") != (c.ClientKeyFile == "") {
+		return errors.New("client_cert_file AND client_key_file MUST BE SET TOGETHER")
+	}
+
+	if c.ProxyURL != "" {
+
+		proxy, err := url.Parse(c.ProxyURL)
+
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			return errors.New("proxy_url MUST BE A URL SUCH AS http://proxy.example.com:8080, GOT: " + c.ProxyURL)
+		}
+	}
+
+	if c.RequestTimeout < 0 || c.MaxRetries < 0 {
+		return errors.New("request_timeout AND max_retries CANNOT BE NEGATIVE")
+	}
+
+	if c.DefaultTenantId != "" && c.TenantName != "" {
+		return errors.New("default_tenant_id AND tenant_name CANNOT BOTH BE SET")
+	}
+
+	if c.DefaultTenantId != "" {
+		if _, err := strconv.Atoi(c.DefaultTenantId); err != nil {
+			return errors.New("default_tenant_id MUST BE A NUMERIC TENANT ID, GOT: " + c.DefaultTenantId)
+		}
+	}
+
+	return nil
+}
+
+// tlsConfig builds the TLS settings used for every request to the
+// CloudCenter Manager. A configured CA is trusted in addition to the system
+// roots, so that CCMs signed by an internal CA can be reached.
+func (c *Config) tlsConfig() (*tls.Config, error) {
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	caCert := []byte(c.CACertPEM)
+
+	if c.CACertFile != "" {
+
+		pem, err := ioutil.ReadFile(c.CACertFile)
+
+		if err != nil {
+			return nil, errors.New("UNABLE TO READ ca_cert_file: " + err.Error())
+		}
+
+		caCert = pem
+	}
+
+	if len(caCert) > 0 {
+
+		pool, err := x509.SystemCertPool()
+
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("NO VALID CERTIFICATES FOUND IN THE CONFIGURED CA CERTIFICATE")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.ClientCertFile != "" {
+
+		certificate, err := tls.LoadX509KeyPair(c.ClientCertFile, c.ClientKeyFile)
+
+		if err != nil {
+			return nil, errors.New("UNABLE TO LOAD CLIENT CERTIFICATE: " + err.Error())
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
 }
 
-func (c *Config) Client() *cloudcenter.Client {
-	return cloudcenter.NewClient(c.Username, c.Password, c.Base_url)
+// httpClient returns the HTTP client the CloudCenter client library uses for
+// every API call. Without a proxy_url the usual HTTPS_PROXY and NO_PROXY
+// environment variables apply.
+func (c *Config) httpClient() (*http.Client, error) {
+
+	tlsConfig, err := c.tlsConfig()
+
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := http.ProxyFromEnvironment
+
+	if c.ProxyURL != "" {
+
+		proxyURL, err := url.Parse(c.ProxyURL)
+
+		if err != nil {
+			return nil, errors.New("UNABLE TO PARSE proxy_url: " + err.Error())
+		}
+
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	timeout := time.Duration(c.RequestTimeout) * time.Second
+
+	transport := &http.Transport{
+		Proxy: proxy,
+		DialContext: (&net.Dialer{
+			Timeout:   timeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   timeout,
+		ResponseHeaderTimeout: timeout,
+	}
+
+	// The client timeout bounds the whole API call, including its retries,
+	// so that an unresponsive CCM cannot hang a run.
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &statusTransport{
+			next: &retryTransport{
+				next:       transport,
+				maxRetries: c.MaxRetries,
+			},
+		},
+	}, nil
+}
+
+// Client validates the configuration and returns a client for the
+// CloudCenter Manager. CloudCenter accepts an API key in place of the
+// password, so whichever has been set is used as the secret.
+func (c *Config) Client() (*cloudcenter.Client, error) {
+
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	httpClient, err := c.httpClient()
+
+	if err != nil {
+		return nil, err
+	}
+
+	secret := c.Password
+
+	if c.ApiKey != "" {
+		secret = c.ApiKey
+	}
+
+	client := cloudcenter.NewClient(c.Username, secret, c.Base_url)
+	client.HTTPClient = httpClient
+
+	if c.TenantName != "" {
+		if err := c.resolveTenantName(client); err != nil {
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+// resolveTenantName looks up the tenant named by tenant_name and uses its ID
+// as the default tenant.
+func (c *Config) resolveTenantName(client *cloudcenter.Client) error {
+
+	tenants, err := client.GetTenants()
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE TENANTS: " + err.Error())
+	}
+
+	var tenant *cloudcenter.Tenant
+
+	for i := range tenants {
+		if tenants[i].Name != c.TenantName {
+			continue
+		}
+		if tenant != nil {
+			return errors.New("MORE THAN ONE TENANT NAMED " + c.TenantName + " FOUND - USE default_tenant_id INSTEAD")
+		}
+		tenant = &tenants[i]
+	}
+
+	if tenant == nil {
+		return errors.New("NO TENANT NAMED " + c.TenantName + " FOUND")
+	}
+
+	c.DefaultTenantId = tenant.Id
+
+	return nil
 }
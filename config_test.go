@@ -0,0 +1,320 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfigValidate(t *testing.T) {
+
+	cases := []struct {
+		name   string
+		config Config
+		err    string
+	}{
+		{
+			name:   "password",
+			config: Config{Base_url: "https://ccm.example.com", Username: "admin", Password: "password"},
+		},
+		{
+			name:   "api key",
+			config: Config{Base_url: "https://ccm.example.com", Username: "admin", ApiKey: "0123456789"},
+		},
+		{
+			name:   "tenant qualified username",
+			config: Config{Base_url: "https://ccm.example.com", Username: "acme/admin", ApiKey: "0123456789"},
+		},
+		{
+			name:   "no credentials",
+			config: Config{Base_url: "https://ccm.example.com", Username: "admin"},
+			err:    "ONE OF password OR api_key MUST BE SET",
+		},
+		{
+			name:   "password and api key",
+			config: Config{Base_url: "https://ccm.example.com", Username: "admin", Password: "password", ApiKey: "0123456789"},
+			err:    "CANNOT BOTH BE SET",
+		},
+		{
+			name:   "no url",
+			config: Config{Username: "admin", Password: "password"},
+			err:    "base_url MUST BE SET",
+		},
+		{
+			name:   "no username",
+			config: Config{Base_url: "https://ccm.example.com", Password: "password"},
+			err:    "username MUST BE SET",
+		},
+		{
+			name:   "empty tenant name",
+			config: Config{Base_url: "https://ccm.example.com", Username: "/admin", Password: "password"},
+			err:    "<tenant_name>/<username>",
+		},
+		{
+			name:   "ca file and pem",
+			config: Config{Base_url: "https://ccm.example.com", Username: "admin", Password: "password", CACertFile: "ca.pem", CACertPEM: "-----BEGIN CERTIFICATE-----"},
+			err:    "ca_cert_file AND ca_cert_pem CANNOT BOTH BE SET",
+		},
+		{
+			name:   "client certificate without key",
+			config: Config{Base_url: "https://ccm.example.com", Username: "admin", Password: "password", ClientCertFile: "client.pem"},
+			err:    "client_cert_file AND client_key_file MUST BE SET TOGETHER",
+		},
+		{
+			name:   "invalid proxy url",
+			config: Config{Base_url: "https://ccm.example.com", Username: "admin", Password: "password", ProxyURL: "proxy.example.com"},
+			err:    "proxy_url MUST BE A URL",
+		},
+		{
+			name:   "negative retries",
+			config: Config{Base_url: "https://ccm.example.com", Username: "admin", Password: "password", MaxRetries: -1},
+			err:    "CANNOT BE NEGATIVE",
+		},
+		{
+			name:   "default tenant id and tenant name",
+			config: Config{Base_url: "https://ccm.example.com", Username: "admin", Password: "password", DefaultTenantId: "1", TenantName: "acme"},
+			err:    "default_tenant_id AND tenant_name CANNOT BOTH BE SET",
+		},
+		{
+			name:   "non-numeric default tenant id",
+			config: Config{Base_url: "https://ccm.example.com", Username: "admin", Password: "password", DefaultTenantId: "acme"},
+			err:    "default_tenant_id MUST BE A NUMERIC TENANT ID",
+		},
+		{
+			name:   "too many qualifiers",
+			config: Config{Base_url: "https://ccm.example.com", Username: "acme/dev/admin", Password: "password"},
+			err:    "<tenant_name>/<username>",
+		},
+	}
+
+	for _, c := range cases {
+
+		err := c.config.validate()
+
+		if c.err == "" && err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+		}
+
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("%s: expected error containing %q, got: %v", c.name, c.err, err)
+		}
+	}
+}
+
+func TestConfigHTTPClient_tls(t *testing.T) {
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caCert := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}))
+
+	cases := []struct {
+		name    string
+		config  Config
+		success bool
+	}{
+		{
+			name:    "untrusted",
+			config:  Config{},
+			success: false,
+		},
+		{
+			name:    "ca cert pem",
+			config:  Config{CACertPEM: caCert},
+			success: true,
+		},
+		{
+			name:    "insecure skip verify",
+			config:  Config{InsecureSkipVerify: true},
+			success: true,
+		},
+	}
+
+	for _, c := range cases {
+
+		httpClient, err := c.config.httpClient()
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+
+		_, err = httpClient.Get(server.URL)
+
+		if c.success && err != nil {
+			t.Errorf("%s: expected request to succeed, got: %s", c.name, err)
+		}
+
+		if !c.success && err == nil {
+			t.Errorf("%s: expected request to fail certificate verification", c.name)
+		}
+	}
+}
+
+func TestConfigHTTPClient_mutualTLS(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "cloudcenter-mtls")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	certFile, keyFile, clientCert := testClientCertificate(t, dir)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	cases := []struct {
+		name    string
+		config  Config
+		success bool
+	}{
+		{
+			name:    "no client certificate",
+			config:  Config{InsecureSkipVerify: true},
+			success: false,
+		},
+		{
+			name:    "client certificate",
+			config:  Config{InsecureSkipVerify: true, ClientCertFile: certFile, ClientKeyFile: keyFile},
+			success: true,
+		},
+	}
+
+	for _, c := range cases {
+
+		httpClient, err := c.config.httpClient()
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+
+		_, err = httpClient.Get(server.URL)
+
+		if c.success && err != nil {
+			t.Errorf("%s: expected request to succeed, got: %s", c.name, err)
+		}
+
+		if !c.success && err == nil {
+			t.Errorf("%s: expected the server to reject the request", c.name)
+		}
+	}
+}
+
+// testClientCertificate writes a self-signed client certificate and its key
+// to dir, returning their paths and the parsed certificate.
+func testClientCertificate(t *testing.T, dir string) (string, string, *x509.Certificate) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return certFile, keyFile, cert
+}
+
+func TestConfigHTTPClient_invalidCA(t *testing.T) {
+
+	config := Config{CACertPEM: "not a certificate"}
+
+	if _, err := config.httpClient(); err == nil || !strings.Contains(err.Error(), "NO VALID CERTIFICATES FOUND") {
+		t.Fatalf("expected invalid CA error, got: %v", err)
+	}
+}
+
+func TestConfigHTTPClient_timeout(t *testing.T) {
+
+	config := Config{RequestTimeout: 45}
+
+	client, err := config.httpClient()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if client.Timeout != 45*time.Second {
+		t.Errorf("expected a client timeout of 45s, got %s", client.Timeout)
+	}
+}
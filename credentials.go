@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// defaultCredentialsFile is read when a profile is selected without naming a
+// credentials file.
+const defaultCredentialsFile = "~/.cloudcenter/credentials"
+
+// profileKeys are the settings a profile in the credentials file may hold.
+var profileKeys = map[string]bool{
+	"url":                  true,
+	"username":             true,
+	"password":             true,
+	"api_key":              true,
+	"ca_cert_file":         true,
+	"insecure_skip_verify": true,
+	"client_cert_file":     true,
+	"client_key_file":      true,
+}
+
+// loadCredentialsProfile reads the named profile from a credentials file in
+// INI format, e.g.
+//
+//	[prod]
+//	url      = https://ccm.example.com
+//	username = terraform
+//	api_key  = 0123456789
+//
+// Lines starting with # or ; are comments.
+func loadCredentialsProfile(path string, name string) (map[string]string, error) {
+
+	path, err := expandHome(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, errors.New("UNABLE TO READ CREDENTIALS FILE: " + err.Error())
+	}
+
+	defer file.Close()
+
+	profiles := map[string]map[string]string{}
+	section := ""
+	scanner := bufio.NewScanner(file)
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if profiles[section] == nil {
+				profiles[section] = map[string]string{}
+			}
+
+		default:
+			parts := strings.SplitN(line, "=", 2)
+
+			if len(parts) != 2 || section == "" {
+				return nil, errors.New("INVALID LINE " + strconv.Itoa(lineNumber) + " IN CREDENTIALS FILE " + path)
+			}
+
+			key := strings.TrimSpace(parts[0])
+
+			if !profileKeys[key] {
+				return nil, errors.New("UNKNOWN SETTING " + key + " ON LINE " + strconv.Itoa(lineNumber) + " IN CREDENTIALS FILE " + path)
+			}
+
+			profiles[section][key] = strings.TrimSpace(parts[1])
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.New("UNABLE TO READ CREDENTIALS FILE: " + err.Error())
+	}
+
+	profile, ok := profiles[name]
+
+	if !ok {
+		return nil, errors.New("NO PROFILE NAMED " + name + " FOUND IN CREDENTIALS FILE " + path)
+	}
+
+	return profile, nil
+}
+
+// applyProfile fills in the settings that have not been set on the provider
+// or through environment variables from a credentials file profile. The
+// password and API key are taken from the profile together, and only when
+// neither has been set, so that the two cannot end up combined.
+func (c *Config) applyProfile(profile map[string]string) error {
+
+	if c.Base_url == "" {
+		c.Base_url = profile["url"]
+	}
+	if c.Username == "" {
+		c.Username = profile["username"]
+	}
+	if c.Password == "" && c.ApiKey == "" {
+		c.Password = profile["password"]
+		c.ApiKey = profile["api_key"]
+	}
+	if c.CACertFile == "" && c.CACertPEM == "" {
+		c.CACertFile = profile["ca_cert_file"]
+	}
+	if c.ClientCertFile == "" && c.ClientKeyFile == "" {
+		c.ClientCertFile = profile["client_cert_file"]
+		c.ClientKeyFile = profile["client_key_file"]
+	}
+
+	if value, ok := profile["insecure_skip_verify"]; ok && !c.InsecureSkipVerify {
+
+		insecure, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return errors.New("insecure_skip_verify IN CREDENTIALS PROFILE MUST BE true OR false, GOT: " + value)
+		}
+
+		c.InsecureSkipVerify = insecure
+	}
+
+	return nil
+}
+
+// expandHome replaces a leading ~ in path with the user's home directory.
+func expandHome(path string) (string, error) {
+
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+
+	if err != nil {
+		return "", errors.New("UNABLE TO FIND HOME DIRECTORY: " + err.Error())
+	}
+
+	return filepath.Join(home, path[1:]), nil
+}
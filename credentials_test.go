@@ -0,0 +1,159 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCredentialsFile = `
+# Shared CloudCenter Managers
+[dev]
+url      = https://ccm-dev.example.com
+username = terraform
+password = dev-password
+
+[prod]
+url                  = https://ccm.example.com
+username             = acme/terraform
+api_key              = 0123456789
+ca_cert_file         = /etc/ssl/ccm-ca.pem
+insecure_skip_verify = false
+`
+
+// testWriteCredentialsFile writes content to a credentials file in a new
+// temporary directory and returns its path.
+func testWriteCredentialsFile(t *testing.T, content string) string {
+
+	dir, err := ioutil.TempDir("", "cloudcenter")
+
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	path := filepath.Join(dir, "credentials")
+
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return path
+}
+
+func TestLoadCredentialsProfile(t *testing.T) {
+
+	path := testWriteCredentialsFile(t, testCredentialsFile)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	profile, err := loadCredentialsProfile(path, "prod")
+
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]string{
+		"url":                  "https://ccm.example.com",
+		"username":             "acme/terraform",
+		"api_key":              "0123456789",
+		"ca_cert_file":         "/etc/ssl/ccm-ca.pem",
+		"insecure_skip_verify": "false",
+	}
+
+	if len(profile) != len(expected) {
+		t.Fatalf("expected %d settings, got: %v", len(expected), profile)
+	}
+
+	for key, value := range expected {
+		if profile[key] != value {
+			t.Errorf("%s: expected %q, got %q", key, value, profile[key])
+		}
+	}
+}
+
+func TestLoadCredentialsProfile_errors(t *testing.T) {
+
+	cases := []struct {
+		name    string
+		content string
+		profile string
+		err     string
+	}{
+		{"missing profile", testCredentialsFile, "staging", "NO PROFILE NAMED staging FOUND"},
+		{"unknown setting", "[dev]\nurl = https://ccm.example.com\npasword = typo\n", "dev", "UNKNOWN SETTING pasword ON LINE 3"},
+		{"setting outside a profile", "url = https://ccm.example.com\n", "dev", "INVALID LINE 1"},
+		{"line without a value", "[dev]\nurl\n", "dev", "INVALID LINE 2"},
+	}
+
+	for _, c := range cases {
+
+		path := testWriteCredentialsFile(t, c.content)
+
+		_, err := loadCredentialsProfile(path, c.profile)
+
+		os.RemoveAll(filepath.Dir(path))
+
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%s: expected error containing %q, got: %v", c.name, c.err, err)
+		}
+	}
+}
+
+func TestConfigApplyProfile(t *testing.T) {
+
+	profile := map[string]string{
+		"url":                  "https://ccm.example.com",
+		"username":             "terraform",
+		"api_key":              "0123456789",
+		"insecure_skip_verify": "true",
+	}
+
+	// Settings made on the provider take precedence over the profile.
+	config := Config{Username: "admin", Password: "password"}
+
+	if err := config.applyProfile(profile); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if config.Base_url != "https://ccm.example.com" {
+		t.Errorf("expected url from profile, got %q", config.Base_url)
+	}
+	if config.Username != "admin" {
+		t.Errorf("expected username from provider, got %q", config.Username)
+	}
+	if config.Password != "password" || config.ApiKey != "" {
+		t.Errorf("expected password from provider and no api key, got %q and %q", config.Password, config.ApiKey)
+	}
+	if !config.InsecureSkipVerify {
+		t.Errorf("expected insecure_skip_verify from profile")
+	}
+
+	if err := config.validate(); err != nil {
+		t.Errorf("expected a valid configuration, got: %s", err)
+	}
+
+	profile["insecure_skip_verify"] = "maybe"
+
+	if err := (&Config{}).applyProfile(profile); err == nil {
+		t.Errorf("expected an error for an invalid insecure_skip_verify")
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"strconv"
+)
+
+func dataSourceActivationProfile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceActivationProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			"activation_profile_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"activation_profile_name"},
+			},
+			"activation_profile_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"activation_profile_id"},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"plan_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bundle_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contract_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_environment_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"activate_regions": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"agree_to_contract": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"send_activation_email": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceActivationProfileRead(d *schema.ResourceData, m interface{}) error {
+
+	if err := setDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTIVATION PROFILE - TENANT ID INCORRECT")
+	}
+
+	activation_profile_id := d.Get("activation_profile_id").(string)
+	activation_profile_name := d.Get("activation_profile_name").(string)
+
+	var activationProfile *cloudcenter.ActivationProfile
+
+	switch {
+	case activation_profile_id != "":
+
+		activation_profile_id_int, err := strconv.Atoi(activation_profile_id)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTIVATION PROFILE - ACTIVATION PROFILE ID INCORRECT")
+		}
+
+		activationProfile, err = client.GetActivationProfile(tenant_id_int, activation_profile_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTIVATION PROFILE: " + err.Error())
+		}
+
+	case activation_profile_name != "":
+
+		activationProfiles, err := client.GetActivationProfiles(tenant_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE ACTIVATION PROFILES: " + err.Error())
+		}
+
+		for i := range activationProfiles {
+			if activationProfiles[i].Name != activation_profile_name {
+				continue
+			}
+			if activationProfile != nil {
+				return errors.New("MORE THAN ONE ACTIVATION PROFILE NAMED " + activation_profile_name + " FOUND - USE activation_profile_id INSTEAD")
+			}
+			activationProfile = &activationProfiles[i]
+		}
+
+		if activationProfile == nil {
+			return errors.New("NO ACTIVATION PROFILE NAMED " + activation_profile_name + " FOUND")
+		}
+
+	default:
+		return errors.New("ONE OF activation_profile_name OR activation_profile_id MUST BE SET")
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + activationProfile.Id)
+
+	return setActivationProfileResourceData(d, activationProfile)
+}
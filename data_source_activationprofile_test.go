@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterActivationProfileDataSource_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterActivationProfileDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cloudcenter_activationprofile.by_name", "activation_profile_id", "cloudcenter_activationprofile.activationprofile", "activation_profile_id"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_activationprofile.by_name", "plan_id", "cloudcenter_activationprofile.activationprofile", "plan_id"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_activationprofile.by_id", "activation_profile_name", "cloudcenter_activationprofile.activationprofile", "activation_profile_name"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_activationprofile.by_id", "activate_regions.#", "cloudcenter_activationprofile.activationprofile", "activate_regions.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCloudCenterActivationProfileDataSourceConfig = `
+resource "cloudcenter_plan" "plan" {
+    plan_name = "terraform-plan-activationprofile"
+    tenant_id = "1"
+    type      = "UNLIMITED_PLAN"
+    price     = 10
+}
+
+resource "cloudcenter_activationprofile" "activationprofile" {
+    activation_profile_name = "terraform-activationprofile-datasource"
+    tenant_id               = "1"
+    plan_id                 = "${cloudcenter_plan.plan.plan_id}"
+
+    activate_regions {
+        region_id = "5"
+    }
+}
+
+data "cloudcenter_activationprofile" "by_name" {
+    activation_profile_name = "${cloudcenter_activationprofile.activationprofile.activation_profile_name}"
+    tenant_id               = "1"
+}
+
+data "cloudcenter_activationprofile" "by_id" {
+    activation_profile_id = "${cloudcenter_activationprofile.activationprofile.activation_profile_id}"
+    tenant_id             = "1"
+}
+`
+
+func TestAccCloudCenterActivationProfileDataSource_ambiguous(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudCenterActivationProfileDataSourceAmbiguousConfig,
+				ExpectError: regexp.MustCompile("MORE THAN ONE ACTIVATION PROFILE NAMED"),
+			},
+			{
+				// Leave the data source out so that destroying the activation profiles
+				// does not read it again.
+				Config: testAccCloudCenterActivationProfileDataSourceDuplicatesConfig,
+			},
+		},
+	})
+}
+
+const testAccCloudCenterActivationProfileDataSourceDuplicatesConfig = `
+resource "cloudcenter_activationprofile" "first" {
+    activation_profile_name = "terraform-activationprofile-duplicate"
+    tenant_id               = "1"
+
+    activate_regions {
+        region_id = "5"
+    }
+}
+
+resource "cloudcenter_activationprofile" "second" {
+    activation_profile_name = "${cloudcenter_activationprofile.first.activation_profile_name}"
+    tenant_id               = "1"
+
+    activate_regions {
+        region_id = "5"
+    }
+}
+`
+
+const testAccCloudCenterActivationProfileDataSourceAmbiguousConfig = testAccCloudCenterActivationProfileDataSourceDuplicatesConfig + `
+data "cloudcenter_activationprofile" "duplicate" {
+    activation_profile_name = "${cloudcenter_activationprofile.second.activation_profile_name}"
+    tenant_id               = "1"
+}
+`
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"strconv"
+)
+
+func dataSourceBundle() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBundleRead,
+
+		Schema: map[string]*schema.Schema{
+			"bundle_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"bundle_name"},
+			},
+			"bundle_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"bundle_id"},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"limit": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"price": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"expiration_date": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"expiration_months": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"disabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"show_only_to_admin": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"number_of_users": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBundleRead(d *schema.ResourceData, m interface{}) error {
+
+	if err := setDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR BUNDLE - TENANT ID INCORRECT")
+	}
+
+	bundle_id := d.Get("bundle_id").(string)
+	bundle_name := d.Get("bundle_name").(string)
+
+	var bundle *cloudcenter.Bundle
+
+	switch {
+	case bundle_id != "":
+
+		bundle_id_int, err := strconv.Atoi(bundle_id)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR BUNDLE - BUNDLE ID INCORRECT")
+		}
+
+		bundle, err = client.GetBundle(tenant_id_int, bundle_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR BUNDLE: " + err.Error())
+		}
+
+	case bundle_name != "":
+
+		bundles, err := client.GetBundles(tenant_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE BUNDLES: " + err.Error())
+		}
+
+		for i := range bundles {
+			if bundles[i].Name != bundle_name {
+				continue
+			}
+			if bundle != nil {
+				return errors.New("MORE THAN ONE BUNDLE NAMED " + bundle_name + " FOUND - USE bundle_id INSTEAD")
+			}
+			bundle = &bundles[i]
+		}
+
+		if bundle == nil {
+			return errors.New("NO BUNDLE NAMED " + bundle_name + " FOUND")
+		}
+
+	default:
+		return errors.New("ONE OF bundle_name OR bundle_id MUST BE SET")
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + bundle.Id)
+
+	return setBundleResourceData(d, bundle)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterBundleDataSource_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterBundleDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cloudcenter_bundle.by_name", "bundle_id", "cloudcenter_bundle.bundle", "bundle_id"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_bundle.by_name", "limit", "cloudcenter_bundle.bundle", "limit"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_bundle.by_id", "bundle_name", "cloudcenter_bundle.bundle", "bundle_name"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_bundle.by_id", "price", "cloudcenter_bundle.bundle", "price"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCloudCenterBundleDataSourceConfig = `
+resource "cloudcenter_bundle" "bundle" {
+    bundle_name     = "terraform-bundle-datasource"
+    type            = "BUDGET_BUNDLE"
+    tenant_id       = "1"
+    limit           = 100
+    price           = 50
+    expiration_date = 1577836800000
+}
+
+data "cloudcenter_bundle" "by_name" {
+    bundle_name = "${cloudcenter_bundle.bundle.bundle_name}"
+    tenant_id   = "1"
+}
+
+data "cloudcenter_bundle" "by_id" {
+    bundle_id = "${cloudcenter_bundle.bundle.bundle_id}"
+    tenant_id = "1"
+}
+`
+
+func TestAccCloudCenterBundleDataSource_ambiguous(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudCenterBundleDataSourceAmbiguousConfig,
+				ExpectError: regexp.MustCompile("MORE THAN ONE BUNDLE NAMED"),
+			},
+			{
+				// Leave the data source out so that destroying the bundles
+				// does not read it again.
+				Config: testAccCloudCenterBundleDataSourceDuplicatesConfig,
+			},
+		},
+	})
+}
+
+const testAccCloudCenterBundleDataSourceDuplicatesConfig = `
+resource "cloudcenter_bundle" "first" {
+    bundle_name     = "terraform-bundle-duplicate"
+    type            = "BUDGET_BUNDLE"
+    tenant_id       = "1"
+    limit           = 100
+    price           = 50
+    expiration_date = 1577836800000
+}
+
+resource "cloudcenter_bundle" "second" {
+    bundle_name     = "${cloudcenter_bundle.first.bundle_name}"
+    type            = "BUDGET_BUNDLE"
+    tenant_id       = "1"
+    limit           = 100
+    price           = 50
+    expiration_date = 1577836800000
+}
+`
+
+const testAccCloudCenterBundleDataSourceAmbiguousConfig = testAccCloudCenterBundleDataSourceDuplicatesConfig + `
+data "cloudcenter_bundle" "duplicate" {
+    bundle_name = "${cloudcenter_bundle.second.bundle_name}"
+    tenant_id   = "1"
+}
+`
@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"strconv"
+)
+
+func dataSourceContract() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceContractRead,
+
+		Schema: map[string]*schema.Schema{
+			"contract_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"contract_name"},
+			},
+			"contract_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"contract_id"},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"terms": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"discount_rate": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"disabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"show_only_to_admin": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"number_of_users": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceContractRead(d *schema.ResourceData, m interface{}) error {
+
+	if err := setDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CONTRACT - TENANT ID INCORRECT")
+	}
+
+	contract_id := d.Get("contract_id").(string)
+	contract_name := d.Get("contract_name").(string)
+
+	var contract *cloudcenter.Contract
+
+	switch {
+	case contract_id != "":
+
+		contract_id_int, err := strconv.Atoi(contract_id)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR CONTRACT - CONTRACT ID INCORRECT")
+		}
+
+		contract, err = client.GetContract(tenant_id_int, contract_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR CONTRACT: " + err.Error())
+		}
+
+	case contract_name != "":
+
+		contracts, err := client.GetContracts(tenant_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE CONTRACTS: " + err.Error())
+		}
+
+		for i := range contracts {
+			if contracts[i].Name != contract_name {
+				continue
+			}
+			if contract != nil {
+				return errors.New("MORE THAN ONE CONTRACT NAMED " + contract_name + " FOUND - USE contract_id INSTEAD")
+			}
+			contract = &contracts[i]
+		}
+
+		if contract == nil {
+			return errors.New("NO CONTRACT NAMED " + contract_name + " FOUND")
+		}
+
+	default:
+		return errors.New("ONE OF contract_name OR contract_id MUST BE SET")
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + contract.Id)
+
+	return setContractResourceData(d, contract)
+}
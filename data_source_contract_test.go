@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterContractDataSource_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterContractDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cloudcenter_contract.by_name", "contract_id", "cloudcenter_contract.contract", "contract_id"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_contract.by_name", "terms", "cloudcenter_contract.contract", "terms"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_contract.by_id", "contract_name", "cloudcenter_contract.contract", "contract_name"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_contract.by_id", "length", "cloudcenter_contract.contract", "length"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCloudCenterContractDataSourceConfig = `
+resource "cloudcenter_contract" "contract" {
+    contract_name = "terraform-contract-datasource"
+    description   = "Looked up by name"
+    tenant_id     = "1"
+    length        = 12
+    terms         = "Terms and conditions"
+    discount_rate = 5
+}
+
+data "cloudcenter_contract" "by_name" {
+    contract_name = "${cloudcenter_contract.contract.contract_name}"
+    tenant_id     = "1"
+}
+
+data "cloudcenter_contract" "by_id" {
+    contract_id = "${cloudcenter_contract.contract.contract_id}"
+    tenant_id   = "1"
+}
+`
+
+func TestAccCloudCenterContractDataSource_ambiguous(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudCenterContractDataSourceAmbiguousConfig,
+				ExpectError: regexp.MustCompile("MORE THAN ONE CONTRACT NAMED"),
+			},
+			{
+				// Leave the data source out so that destroying the contracts
+				// does not read it again.
+				Config: testAccCloudCenterContractDataSourceDuplicatesConfig,
+			},
+		},
+	})
+}
+
+const testAccCloudCenterContractDataSourceDuplicatesConfig = `
+resource "cloudcenter_contract" "first" {
+    contract_name = "terraform-contract-duplicate"
+    tenant_id     = "1"
+    length        = 12
+    terms         = "Terms and conditions"
+    discount_rate = 5
+}
+
+resource "cloudcenter_contract" "second" {
+    contract_name = "${cloudcenter_contract.first.contract_name}"
+    tenant_id     = "1"
+    length        = 12
+    terms         = "Terms and conditions"
+    discount_rate = 5
+}
+`
+
+const testAccCloudCenterContractDataSourceAmbiguousConfig = testAccCloudCenterContractDataSourceDuplicatesConfig + `
+data "cloudcenter_contract" "duplicate" {
+    contract_name = "${cloudcenter_contract.second.contract_name}"
+    tenant_id     = "1"
+}
+`
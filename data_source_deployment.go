@@ -0,0 +1,215 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"strconv"
+)
+
+func dataSourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDeploymentRead,
+
+		Schema: map[string]*schema.Schema{
+			"job_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"deployment_name"},
+			},
+			"deployment_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"job_id"},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"app_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"app_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_environment_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nodes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tier_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cloud_instance_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDeploymentRead(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	job_id := d.Get("job_id").(string)
+	deployment_name := d.Get("deployment_name").(string)
+
+	var job *cloudcenter.Job
+
+	switch {
+	case job_id != "":
+
+		job_id_int, err := strconv.Atoi(job_id)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR DEPLOYMENT - JOB ID INCORRECT")
+		}
+
+		job, err = client.GetJob(job_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR DEPLOYMENT: " + err.Error())
+		}
+
+	case deployment_name != "":
+
+		jobs, err := client.GetJobs()
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DEPLOYMENTS: " + err.Error())
+		}
+
+		var match *cloudcenter.Job
+
+		for i := range jobs {
+			if jobs[i].Name != deployment_name {
+				continue
+			}
+			if match != nil {
+				return errors.New("MORE THAN ONE DEPLOYMENT NAMED " + deployment_name + " FOUND - USE job_id INSTEAD")
+			}
+			match = &jobs[i]
+		}
+
+		if match == nil {
+			return errors.New("NO DEPLOYMENT NAMED " + deployment_name + " FOUND")
+		}
+
+		// The list of jobs leaves out the tiers of each deployment, so
+		// fetch the match again for its nodes.
+		job_id_int, err := strconv.Atoi(match.Id)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR DEPLOYMENT - JOB ID INCORRECT")
+		}
+
+		job, err = client.GetJob(job_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR DEPLOYMENT: " + err.Error())
+		}
+
+	default:
+		return errors.New("ONE OF deployment_name OR job_id MUST BE SET")
+	}
+
+	d.SetId(job.Id)
+
+	return setDeploymentDataSourceData(d, job)
+}
+
+func setDeploymentDataSourceData(d *schema.ResourceData, u *cloudcenter.Job) error {
+
+	if err := d.Set("job_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("deployment_name", u.Name); err != nil {
+		return errors.New("CANNOT SET DEPLOYMENT NAME")
+	}
+	if err := d.Set("status", u.Status); err != nil {
+		return errors.New("CANNOT SET STATUS")
+	}
+	if err := d.Set("app_id", u.AppId); err != nil {
+		return errors.New("CANNOT SET APP ID")
+	}
+	if err := d.Set("app_version", u.AppVersion); err != nil {
+		return errors.New("CANNOT SET APP VERSION")
+	}
+	if err := d.Set("deployment_environment_id", u.EnvironmentId); err != nil {
+		return errors.New("CANNOT SET DEPLOYMENT ENVIRONMENT ID")
+	}
+	if err := d.Set("nodes", flattenDeploymentNodes(u.Jobs)); err != nil {
+		return errors.New("CANNOT SET NODES")
+	}
+
+	return nil
+}
+
+// flattenDeploymentNodes lists the nodes of every tier of a deployment. Each
+// tier is a child job of the deployment's job.
+func flattenDeploymentNodes(tiers []cloudcenter.Job) []interface{} {
+
+	result := []interface{}{}
+
+	for _, tier := range tiers {
+		for _, node := range tier.Nodes {
+			result = append(result, map[string]interface{}{
+				"tier_name":         tier.Name,
+				"hostname":          node.HostName,
+				"public_ip":         node.PublicIp,
+				"private_ip":        node.PrivateIp,
+				"instance_type":     node.InstanceType,
+				"cloud_instance_id": node.CloudInstanceId,
+			})
+		}
+	}
+
+	return result
+}
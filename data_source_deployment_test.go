@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterDeploymentDataSource_basic(t *testing.T) {
+	testAccCCM.setDefault("jobs", "jobs", []interface{}{
+		map[string]interface{}{
+			"name": "web",
+			"nodes": []interface{}{
+				map[string]interface{}{
+					"hostName":        "web-1",
+					"publicIp":        "203.0.113.1",
+					"privateIp":       "10.0.0.1",
+					"instanceType":    "m4.large",
+					"cloudInstanceId": "i-0001",
+				},
+				map[string]interface{}{
+					"hostName":        "web-2",
+					"publicIp":        "203.0.113.2",
+					"privateIp":       "10.0.0.2",
+					"instanceType":    "m4.large",
+					"cloudInstanceId": "i-0002",
+				},
+			},
+		},
+	})
+	defer testAccCCM.clearDefault("jobs", "jobs")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterDeploymentDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cloudcenter_deployment.by_name", "job_id", "cloudcenter_job.job", "job_id"),
+					resource.TestCheckResourceAttr("data.cloudcenter_deployment.by_name", "nodes.#", "2"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_deployment.by_id", "deployment_name", "cloudcenter_job.job", "job_name"),
+					resource.TestCheckResourceAttr("data.cloudcenter_deployment.by_id", "status", "JobRunning"),
+					resource.TestCheckResourceAttr("data.cloudcenter_deployment.by_id", "nodes.#", "2"),
+					resource.TestCheckResourceAttr("data.cloudcenter_deployment.by_id", "nodes.0.tier_name", "web"),
+					resource.TestCheckResourceAttr("data.cloudcenter_deployment.by_id", "nodes.0.hostname", "web-1"),
+					resource.TestCheckResourceAttr("data.cloudcenter_deployment.by_id", "nodes.0.public_ip", "203.0.113.1"),
+					resource.TestCheckResourceAttr("data.cloudcenter_deployment.by_id", "nodes.0.private_ip", "10.0.0.1"),
+					resource.TestCheckResourceAttr("data.cloudcenter_deployment.by_id", "nodes.0.instance_type", "m4.large"),
+					resource.TestCheckResourceAttr("data.cloudcenter_deployment.by_id", "nodes.0.cloud_instance_id", "i-0001"),
+					resource.TestCheckResourceAttr("data.cloudcenter_deployment.by_id", "nodes.1.public_ip", "203.0.113.2"),
+					resource.TestCheckResourceAttr("data.cloudcenter_deployment.by_id", "nodes.1.cloud_instance_id", "i-0002"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCloudCenterDeploymentDataSourceConfig = `
+resource "cloudcenter_job" "job" {
+    job_name                  = "terraform-job-datasource"
+    app_id                    = "10"
+    app_version               = "1.0"
+    deployment_environment_id = "20"
+    tenant_id                 = "1"
+}
+
+data "cloudcenter_deployment" "by_name" {
+    deployment_name = "${cloudcenter_job.job.job_name}"
+}
+
+data "cloudcenter_deployment" "by_id" {
+    job_id = "${cloudcenter_job.job.job_id}"
+}
+`
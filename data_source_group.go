@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"strconv"
+)
+
+func dataSourceGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"group_name"},
+			},
+			"group_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"group_id"},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_by_sso": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"roles": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupRead(d *schema.ResourceData, m interface{}) error {
+
+	if err := setDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR GROUP - TENANT ID INCORRECT")
+	}
+
+	group_id := d.Get("group_id").(string)
+	group_name := d.Get("group_name").(string)
+
+	var group *cloudcenter.Group
+
+	switch {
+	case group_id != "":
+
+		group_id_int, err := strconv.Atoi(group_id)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR GROUP - GROUP ID INCORRECT")
+		}
+
+		group, err = client.GetGroup(tenant_id_int, group_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR GROUP: " + err.Error())
+		}
+
+	case group_name != "":
+
+		groups, err := client.GetGroups(tenant_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE GROUPS: " + err.Error())
+		}
+
+		for i := range groups {
+			if groups[i].Name != group_name {
+				continue
+			}
+			if group != nil {
+				return errors.New("MORE THAN ONE GROUP NAMED " + group_name + " FOUND - USE group_id INSTEAD")
+			}
+			group = &groups[i]
+		}
+
+		if group == nil {
+			return errors.New("NO GROUP NAMED " + group_name + " FOUND")
+		}
+
+	default:
+		return errors.New("ONE OF group_name OR group_id MUST BE SET")
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + group.Id)
+
+	return setGroupResourceData(d, group)
+}
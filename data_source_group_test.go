@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterGroupDataSource_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterGroupDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cloudcenter_group.by_name", "group_id", "cloudcenter_group.group", "group_id"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_group.by_name", "description", "cloudcenter_group.group", "description"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_group.by_name", "users.#", "cloudcenter_group.group", "users.#"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_group.by_id", "group_name", "cloudcenter_group.group", "group_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCloudCenterGroupDataSourceConfig = `
+resource "cloudcenter_group" "group" {
+    group_name  = "terraform-group-datasource"
+    description = "Looked up by name"
+    tenant_id   = "1"
+
+    users {
+        user_id = "2"
+    }
+
+    roles {
+        role_id = "3"
+    }
+}
+
+data "cloudcenter_group" "by_name" {
+    group_name = "${cloudcenter_group.group.group_name}"
+    tenant_id  = "1"
+}
+
+data "cloudcenter_group" "by_id" {
+    group_id  = "${cloudcenter_group.group.group_id}"
+    tenant_id = "1"
+}
+`
+
+func TestAccCloudCenterGroupDataSource_ambiguous(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudCenterGroupDataSourceAmbiguousConfig,
+				ExpectError: regexp.MustCompile("MORE THAN ONE GROUP NAMED"),
+			},
+			{
+				// Leave the data source out so that destroying the groups
+				// does not read it again.
+				Config: testAccCloudCenterGroupDataSourceDuplicatesConfig,
+			},
+		},
+	})
+}
+
+const testAccCloudCenterGroupDataSourceDuplicatesConfig = `
+resource "cloudcenter_group" "first" {
+    group_name = "terraform-group-duplicate"
+    tenant_id  = "1"
+
+    users {
+        user_id = "2"
+    }
+
+    roles {
+        role_id = "3"
+    }
+}
+
+resource "cloudcenter_group" "second" {
+    group_name = "${cloudcenter_group.first.group_name}"
+    tenant_id  = "1"
+
+    users {
+        user_id = "2"
+    }
+
+    roles {
+        role_id = "3"
+    }
+}
+`
+
+const testAccCloudCenterGroupDataSourceAmbiguousConfig = testAccCloudCenterGroupDataSourceDuplicatesConfig + `
+data "cloudcenter_group" "duplicate" {
+    group_name = "${cloudcenter_group.second.group_name}"
+    tenant_id  = "1"
+}
+`
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"strconv"
+)
+
+func dataSourceInstanceType() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceInstanceTypeRead,
+
+		Schema: map[string]*schema.Schema{
+			"min_cpu": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"min_memory": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"architecture": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cloud_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_type_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_type_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cpu": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"memory": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"storage": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"nics": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"price": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// dataSourceInstanceTypeRead picks the cheapest instance type in the region
+// that has at least min_cpu CPUs and min_memory MB of memory. Ties are broken
+// by name so that the choice is stable between runs.
+func dataSourceInstanceTypeRead(d *schema.ResourceData, m interface{}) error {
+
+	if err := setDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR INSTANCE TYPE - TENANT ID INCORRECT")
+	}
+
+	cloud_id_int, err := strconv.Atoi(d.Get("cloud_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR INSTANCE TYPE - CLOUD ID INCORRECT")
+	}
+
+	region_id_int, err := strconv.Atoi(d.Get("region_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR INSTANCE TYPE - REGION ID INCORRECT")
+	}
+
+	instanceTypes, err := client.GetInstanceTypes(tenant_id_int, cloud_id_int, region_id_int)
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE INSTANCE TYPES: " + err.Error())
+	}
+
+	min_cpu := d.Get("min_cpu").(int)
+	min_memory := d.Get("min_memory").(int)
+	architecture := d.Get("architecture").(string)
+
+	var instanceType *cloudcenter.InstanceType
+
+	for i := range instanceTypes {
+
+		candidate := &instanceTypes[i]
+
+		if candidate.NumCpus < min_cpu || candidate.MemorySize < min_memory {
+			continue
+		}
+		if architecture != "" && candidate.SupportedArchitecture != architecture && candidate.SupportedArchitecture != "X32_X64" {
+			continue
+		}
+
+		if instanceType == nil ||
+			candidate.CostPerHour < instanceType.CostPerHour ||
+			(candidate.CostPerHour == instanceType.CostPerHour && candidate.Name < instanceType.Name) {
+			instanceType = candidate
+		}
+	}
+
+	if instanceType == nil {
+		return errors.New("NO INSTANCE TYPE FOUND WITH AT LEAST " + strconv.Itoa(min_cpu) + " CPU AND " + strconv.Itoa(min_memory) + " MEMORY")
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + d.Get("cloud_id").(string) + ":" + d.Get("region_id").(string) + ":" + instanceType.Id)
+
+	return setInstanceTypeResourceData(d, instanceType)
+}
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterInstanceTypeDataSource_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Create the instance types first, as the data source would
+				// otherwise be read again on every plan.
+				Config: testAccCloudCenterInstanceTypesConfig,
+			},
+			{
+				Config: testAccCloudCenterInstanceTypesConfig + testAccCloudCenterInstanceTypeDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cloudcenter_instance_type.cheapest", "instance_type_id", "cloudcenter_instance_type.large", "instance_type_id"),
+					resource.TestCheckResourceAttr("data.cloudcenter_instance_type.cheapest", "instance_type_name", "large"),
+					resource.TestCheckResourceAttr("data.cloudcenter_instance_type.cheapest", "cpu", "4"),
+					resource.TestCheckResourceAttr("data.cloudcenter_instance_type.cheapest", "price", "0.2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCloudCenterInstanceTypesConfig = `
+resource "cloudcenter_cloud" "cloud" {
+    cloud_name   = "terraform-cloud"
+    cloud_family = "Amazon"
+    tenant_id    = "1"
+}
+
+resource "cloudcenter_cloud_region" "region" {
+    cloud_id    = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_name = "us-east-1"
+    tenant_id   = "1"
+}
+
+resource "cloudcenter_instance_type" "small" {
+    instance_type_name = "small"
+    cpu                = 1
+    memory             = 2048
+    price              = 0.05
+    cloud_id           = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_id          = "${cloudcenter_cloud_region.region.region_id}"
+    tenant_id          = "1"
+}
+
+resource "cloudcenter_instance_type" "medium" {
+    instance_type_name = "medium"
+    cpu                = 2
+    memory             = 4096
+    price              = 0.1
+    cloud_id           = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_id          = "${cloudcenter_cloud_region.region.region_id}"
+    tenant_id          = "1"
+}
+
+resource "cloudcenter_instance_type" "large" {
+    instance_type_name = "large"
+    cpu                = 4
+    memory             = 8192
+    price              = 0.2
+    cloud_id           = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_id          = "${cloudcenter_cloud_region.region.region_id}"
+    tenant_id          = "1"
+}
+
+resource "cloudcenter_instance_type" "xlarge" {
+    instance_type_name = "xlarge"
+    cpu                = 8
+    memory             = 16384
+    price              = 0.4
+    cloud_id           = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_id          = "${cloudcenter_cloud_region.region.region_id}"
+    tenant_id          = "1"
+}
+`
+
+const testAccCloudCenterInstanceTypeDataSourceConfig = `
+data "cloudcenter_instance_type" "cheapest" {
+    min_cpu    = 3
+    min_memory = 4096
+    cloud_id   = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_id  = "${cloudcenter_cloud_region.region.region_id}"
+    tenant_id  = "1"
+}
+`
+
+func TestAccCloudCenterInstanceTypeDataSource_noMatch(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "cloudcenter_cloud" "cloud" {
+    cloud_name   = "terraform-cloud"
+    cloud_family = "Amazon"
+    tenant_id    = "1"
+}
+
+resource "cloudcenter_cloud_region" "region" {
+    cloud_id    = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_name = "us-east-1"
+    tenant_id   = "1"
+}
+
+resource "cloudcenter_instance_type" "small" {
+    instance_type_name = "small"
+    cpu                = 1
+    memory             = 2048
+    price              = 0.05
+    cloud_id           = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_id          = "${cloudcenter_cloud_region.region.region_id}"
+    tenant_id          = "1"
+}
+
+data "cloudcenter_instance_type" "huge" {
+    min_cpu    = 64
+    min_memory = 4096
+    cloud_id   = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_id  = "${cloudcenter_cloud_region.region.region_id}"
+    tenant_id  = "1"
+
+    depends_on = ["cloudcenter_instance_type.small"]
+}
+`,
+				ExpectError: regexp.MustCompile("NO INSTANCE TYPE FOUND WITH AT LEAST 64 CPU AND 4096 MEMORY"),
+			},
+		},
+	})
+}
@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"strconv"
+)
+
+func dataSourcePlan() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePlanRead,
+
+		Schema: map[string]*schema.Schema{
+			"plan_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"plan_name"},
+			},
+			"plan_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"plan_id"},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monthly_limit": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"node_hour_increment": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"included_bundle_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"price": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"one_time_fee": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"annual_fee": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"storage_rate": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"hourly_rate": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"overage_rate": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"overage_limit": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"restricted_to_app_store_only": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"bill_to_vendor": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"enable_rollover": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"disabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"show_only_to_admin": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"number_of_users": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"number_of_projects": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePlanRead(d *schema.ResourceData, m interface{}) error {
+
+	if err := setDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PLAN - TENANT ID INCORRECT")
+	}
+
+	plan_id := d.Get("plan_id").(string)
+	plan_name := d.Get("plan_name").(string)
+
+	var plan *cloudcenter.Plan
+
+	switch {
+	case plan_id != "":
+
+		plan_id_int, err := strconv.Atoi(plan_id)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR PLAN - PLAN ID INCORRECT")
+		}
+
+		plan, err = client.GetPlan(tenant_id_int, plan_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR PLAN: " + err.Error())
+		}
+
+	case plan_name != "":
+
+		plans, err := client.GetPlans(tenant_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE PLANS: " + err.Error())
+		}
+
+		for i := range plans {
+			if plans[i].Name != plan_name {
+				continue
+			}
+			if plan != nil {
+				return errors.New("MORE THAN ONE PLAN NAMED " + plan_name + " FOUND - USE plan_id INSTEAD")
+			}
+			plan = &plans[i]
+		}
+
+		if plan == nil {
+			return errors.New("NO PLAN NAMED " + plan_name + " FOUND")
+		}
+
+	default:
+		return errors.New("ONE OF plan_name OR plan_id MUST BE SET")
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + plan.Id)
+
+	return setPlanResourceData(d, plan)
+}
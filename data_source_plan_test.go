@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterPlanDataSource_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterPlanDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cloudcenter_plan.by_name", "plan_id", "cloudcenter_plan.plan", "plan_id"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_plan.by_name", "price", "cloudcenter_plan.plan", "price"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_plan.by_id", "plan_name", "cloudcenter_plan.plan", "plan_name"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_plan.by_id", "type", "cloudcenter_plan.plan", "type"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCloudCenterPlanDataSourceConfig = `
+resource "cloudcenter_plan" "plan" {
+    plan_name   = "terraform-plan-datasource"
+    description = "Looked up by name"
+    tenant_id   = "1"
+    type        = "UNLIMITED_PLAN"
+    price       = 10
+}
+
+data "cloudcenter_plan" "by_name" {
+    plan_name = "${cloudcenter_plan.plan.plan_name}"
+    tenant_id = "1"
+}
+
+data "cloudcenter_plan" "by_id" {
+    plan_id   = "${cloudcenter_plan.plan.plan_id}"
+    tenant_id = "1"
+}
+`
+
+func TestAccCloudCenterPlanDataSource_notFound(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "cloudcenter_plan" "missing" {
+    plan_name = "terraform-plan-missing"
+    tenant_id = "1"
+}
+`,
+				ExpectError: regexp.MustCompile("NO PLAN NAMED terraform-plan-missing FOUND"),
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterPlanDataSource_ambiguous(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudCenterPlanDataSourceAmbiguousConfig,
+				ExpectError: regexp.MustCompile("MORE THAN ONE PLAN NAMED"),
+			},
+			{
+				// Leave the data source out so that destroying the plans
+				// does not read it again.
+				Config: testAccCloudCenterPlanDataSourceDuplicatesConfig,
+			},
+		},
+	})
+}
+
+const testAccCloudCenterPlanDataSourceDuplicatesConfig = `
+resource "cloudcenter_plan" "first" {
+    plan_name = "terraform-plan-duplicate"
+    tenant_id = "1"
+    type      = "UNLIMITED_PLAN"
+    price     = 10
+}
+
+resource "cloudcenter_plan" "second" {
+    plan_name = "${cloudcenter_plan.first.plan_name}"
+    tenant_id = "1"
+    type      = "UNLIMITED_PLAN"
+    price     = 10
+}
+`
+
+const testAccCloudCenterPlanDataSourceAmbiguousConfig = testAccCloudCenterPlanDataSourceDuplicatesConfig + `
+data "cloudcenter_plan" "duplicate" {
+    plan_name = "${cloudcenter_plan.second.plan_name}"
+    tenant_id = "1"
+}
+`
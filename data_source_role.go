@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"strconv"
+)
+
+func dataSourceRole() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRoleRead,
+
+		Schema: map[string]*schema.Schema{
+			"role_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"role_name"},
+			},
+			"role_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"role_id"},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"perms": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"oob_role": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"object_permissions": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permissions": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRoleRead(d *schema.ResourceData, m interface{}) error {
+
+	if err := setDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ROLE - TENANT ID INCORRECT")
+	}
+
+	role_id := d.Get("role_id").(string)
+	role_name := d.Get("role_name").(string)
+
+	var role *cloudcenter.Role
+
+	switch {
+	case role_id != "":
+
+		role_id_int, err := strconv.Atoi(role_id)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR ROLE - ROLE ID INCORRECT")
+		}
+
+		role, err = client.GetRole(tenant_id_int, role_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR ROLE: " + err.Error())
+		}
+
+	case role_name != "":
+
+		roles, err := client.GetRoles(tenant_id_int)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE ROLES: " + err.Error())
+		}
+
+		for i := range roles {
+			if roles[i].Name != role_name {
+				continue
+			}
+			if role != nil {
+				return errors.New("MORE THAN ONE ROLE NAMED " + role_name + " FOUND - USE role_id INSTEAD")
+			}
+			role = &roles[i]
+		}
+
+		if role == nil {
+			return errors.New("NO ROLE NAMED " + role_name + " FOUND")
+		}
+
+	default:
+		return errors.New("ONE OF role_name OR role_id MUST BE SET")
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + role.Id)
+
+	return setRoleResourceData(d, role)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterRoleDataSource_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterRoleDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cloudcenter_role.by_name", "role_id", "cloudcenter_role.role", "role_id"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_role.by_name", "description", "cloudcenter_role.role", "description"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_role.by_name", "perms.#", "cloudcenter_role.role", "perms.#"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_role.by_id", "role_name", "cloudcenter_role.role", "role_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCloudCenterRoleDataSourceConfig = `
+resource "cloudcenter_role" "role" {
+    role_name   = "terraform-role-datasource"
+    description = "Looked up by name"
+    tenant_id   = "1"
+    perms       = ["ALL_APPS_VIEW"]
+}
+
+data "cloudcenter_role" "by_name" {
+    role_name = "${cloudcenter_role.role.role_name}"
+    tenant_id = "1"
+}
+
+data "cloudcenter_role" "by_id" {
+    role_id   = "${cloudcenter_role.role.role_id}"
+    tenant_id = "1"
+}
+`
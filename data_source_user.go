@@ -0,0 +1,189 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"username", "email_address"},
+			},
+			"username": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"user_id", "email_address"},
+			},
+			"email_address": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"user_id", "username"},
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"first_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"company_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phone_number": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_source": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_admin": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"activation_profile_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"has_subscription_plan": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"email_verified": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"external_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_keys": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disable_reason": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"detail": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"activation_data": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"co_admin": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceUserRead(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	user_id := d.Get("user_id").(string)
+	username := d.Get("username").(string)
+	email_address := d.Get("email_address").(string)
+
+	var user *cloudcenter.User
+
+	switch {
+	case email_address != "":
+
+		u, err := client.GetUserFromEmail(email_address)
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE DETAILS FOR USER: " + email_address + ": " + err.Error())
+		}
+
+		user = u
+
+	case user_id != "" || username != "":
+
+		users, err := client.GetUsers()
+
+		if err != nil {
+			return errors.New("UNABLE TO RETRIEVE USERS: " + err.Error())
+		}
+
+		for i := range users {
+			if (user_id != "" && users[i].Id == user_id) || (username != "" && users[i].Username == username) {
+				user = &users[i]
+				break
+			}
+		}
+
+		if user == nil {
+			return errors.New("NO USER FOUND MATCHING USER ID OR USERNAME: " + user_id + username)
+		}
+
+	default:
+		return errors.New("ONE OF email_address, username OR user_id MUST BE SET")
+	}
+
+	d.SetId(user.TenantId + ":" + user.Id)
+
+	return setUserResourceData(d, user)
+}
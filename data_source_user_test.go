@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterUserDataSource_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterUserDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cloudcenter_user.by_email", "user_id", "cloudcenter_user.user", "user_id"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_user.by_email", "first_name", "cloudcenter_user.user", "first_name"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_user.by_id", "email_address", "cloudcenter_user.user", "email_address"),
+					resource.TestCheckResourceAttrPair("data.cloudcenter_user.by_id", "tenant_id", "cloudcenter_user.user", "tenant_id"),
+					resource.TestCheckResourceAttr("data.cloudcenter_user.by_id", "external_id", "datasource-external"),
+					resource.TestCheckResourceAttr("data.cloudcenter_user.by_id", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCloudCenterUserDataSourceConfig = `
+resource "cloudcenter_user" "user" {
+    email_address = "datasource@mydomain.com"
+    first_name    = "Terraform"
+    last_name     = "Plugin"
+    password      = "myPassword"
+    external_id   = "datasource-external"
+    enabled       = true
+    tenant_id     = "1"
+}
+
+data "cloudcenter_user" "by_email" {
+    email_address = "${cloudcenter_user.user.email_address}"
+}
+
+data "cloudcenter_user" "by_id" {
+    user_id = "${cloudcenter_user.user.user_id}"
+}
+`
@@ -0,0 +1,377 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// fakeCCM is an in-process stand-in for the CloudCenter Manager REST API.
+//
+// It models every collection generically: a path such as
+// /v1/tenants/1/roles is a collection, and /v1/tenants/1/roles/7 is an
+// object within it. Objects are stored as decoded JSON, so users, groups,
+// roles, plans, bundles, contracts, activation profiles and images are all
+// kept per tenant simply by virtue of their path. Object IDs are unique
+// across the whole server, which lets tests find an object by ID alone.
+//
+// The body each object was last uploaded with is kept as well, and is
+// returned as-is by a GET of <object>/export, as for application profiles.
+type fakeCCM struct {
+	mu       sync.Mutex
+	server   *httptest.Server
+	lastId   int
+	objects  map[string]map[string]map[string]interface{}
+	uploads  map[string][]byte
+	defaults map[string]map[string]interface{}
+	faults   []fakeCCMFault
+
+	// purgeTerminated removes a job as soon as it is terminated, as the CCM
+	// may do, rather than leaving it stopped.
+	purgeTerminated bool
+}
+
+// fakeCCMFault makes the next request whose method matches and whose path
+// contains path fail with status.
+type fakeCCMFault struct {
+	method  string
+	path    string
+	status  int
+	message string
+}
+
+// fakeCCMActionStatuses maps the actions that can be requested on a job to
+// the status the job settles in once the action has completed.
+var fakeCCMActionStatuses = map[string]string{
+	"SUSPEND":   "JobSuspended",
+	"RESUME":    "JobRunning",
+	"TERMINATE": "JobStopped",
+}
+
+func newFakeCCM() *fakeCCM {
+
+	f := &fakeCCM{
+		objects:  map[string]map[string]map[string]interface{}{},
+		uploads:  map[string][]byte{},
+		defaults: map[string]map[string]interface{}{},
+	}
+
+	// Jobs are reported as running as soon as they are submitted.
+	f.setDefault("jobs", "status", "JobRunning")
+
+	f.server = httptest.NewServer(f)
+
+	return f
+}
+
+// injectFault registers a one-off failure for the next matching request.
+func (f *fakeCCM) injectFault(method string, path string, status int) {
+	f.injectFaultMessage(method, path, status, "INJECTED FAULT")
+}
+
+// injectFaultMessage is injectFault with a chosen error message.
+func (f *fakeCCM) injectFaultMessage(method string, path string, status int, message string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.faults = append(f.faults, fakeCCMFault{method: method, path: path, status: status, message: message})
+}
+
+// setDefault sets a field on every object subsequently created in a
+// collection with the given name, e.g. the status of a new job.
+func (f *fakeCCM) setDefault(name string, key string, value interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.defaults[name] == nil {
+		f.defaults[name] = map[string]interface{}{}
+	}
+
+	f.defaults[name][key] = value
+}
+
+// clearDefault undoes setDefault for a field of a collection.
+func (f *fakeCCM) clearDefault(name string, key string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.defaults[name], key)
+}
+
+// purgeOnTerminate sets whether terminated jobs are purged straight away.
+func (f *fakeCCM) purgeOnTerminate(purge bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.purgeTerminated = purge
+}
+
+// exists reports whether an object with the given ID is stored anywhere.
+func (f *fakeCCM) exists(id string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, collection := range f.objects {
+		if _, ok := collection[id]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// get returns a field of a stored object, e.g. a password the API never
+// reports back.
+func (f *fakeCCM) get(id string, key string) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, collection := range f.objects {
+		if object, ok := collection[id]; ok {
+			return object[key]
+		}
+	}
+
+	return nil
+}
+
+// update sets a field on a stored object behind Terraform's back, e.g. the
+// status of a job terminated in the CloudCenter UI.
+func (f *fakeCCM) update(id string, key string, value interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, collection := range f.objects {
+		if object, ok := collection[id]; ok {
+			object[key] = value
+		}
+	}
+}
+
+// remove deletes an object behind Terraform's back, as a user of the
+// CloudCenter UI would.
+func (f *fakeCCM) remove(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, collection := range f.objects {
+		delete(collection, id)
+	}
+
+	delete(f.uploads, id)
+}
+
+// modifyUpload replaces the body an object was uploaded with, as an edit of
+// an application profile in the CloudCenter UI would.
+func (f *fakeCCM) modifyUpload(id string, body string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.uploads[id] = []byte(body)
+}
+
+func (f *fakeCCM) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, fault := range f.faults {
+		if fault.method == r.Method && strings.Contains(r.URL.Path, fault.path) {
+			f.faults = append(f.faults[:i], f.faults[i+1:]...)
+			writeFakeCCMError(w, fault.status, fault.message)
+			return
+		}
+	}
+
+	if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/export") {
+		_, id := splitFakeCCMPath(strings.TrimSuffix(r.URL.Path, "/export"))
+		if upload, ok := f.uploads[id]; ok {
+			w.WriteHeader(http.StatusOK)
+			w.Write(upload)
+			return
+		}
+		writeFakeCCMError(w, http.StatusNotFound, "OBJECT "+id+" NOT FOUND")
+		return
+	}
+
+	collection, id := splitFakeCCMPath(r.URL.Path)
+
+	if f.objects[collection] == nil {
+		f.objects[collection] = map[string]map[string]interface{}{}
+	}
+
+	objects := f.objects[collection]
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		// Like the CCM, lists leave out the child jobs of each job.
+		list := []map[string]interface{}{}
+		for _, object := range objects {
+			summary := map[string]interface{}{}
+			for key, value := range object {
+				if key != "jobs" {
+					summary[key] = value
+				}
+			}
+			list = append(list, summary)
+		}
+		name := collection[strings.LastIndex(collection, "/")+1:]
+		writeFakeCCMJSON(w, http.StatusOK, map[string]interface{}{
+			"resource": f.server.URL + collection,
+			"size":     len(list),
+			name:       list,
+		})
+
+	case id == "" && r.Method == http.MethodPost:
+		object, upload, err := decodeFakeCCMBody(r)
+		if err != nil {
+			writeFakeCCMError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for key, value := range f.defaults[collection[strings.LastIndex(collection, "/")+1:]] {
+			object[key] = value
+		}
+		f.lastId++
+		id = strconv.Itoa(f.lastId)
+		object["id"] = id
+		object["resource"] = f.server.URL + collection + "/" + id
+		objects[id] = object
+		f.uploads[id] = upload
+		writeFakeCCMJSON(w, http.StatusCreated, object)
+
+	case id == "":
+		writeFakeCCMError(w, http.StatusMethodNotAllowed, r.Method+" NOT ALLOWED")
+
+	case objects[id] == nil:
+		writeFakeCCMError(w, http.StatusNotFound, "OBJECT "+id+" NOT FOUND")
+
+	case r.Method == http.MethodGet:
+		writeFakeCCMJSON(w, http.StatusOK, objects[id])
+
+	case r.Method == http.MethodPut:
+		object, upload, err := decodeFakeCCMBody(r)
+		if err != nil {
+			writeFakeCCMError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if action, ok := object["action"].(string); ok {
+			objects[id]["status"] = fakeCCMActionStatuses[action]
+			writeFakeCCMJSON(w, http.StatusOK, objects[id])
+			if action == "TERMINATE" && f.purgeTerminated {
+				delete(objects, id)
+			}
+			return
+		}
+		// Like the CCM, an update without a password keeps the current one.
+		if password, ok := object["password"]; (!ok || password == "") && objects[id]["password"] != nil {
+			object["password"] = objects[id]["password"]
+		}
+		object["id"] = id
+		object["resource"] = objects[id]["resource"]
+		objects[id] = object
+		f.uploads[id] = upload
+		writeFakeCCMJSON(w, http.StatusOK, object)
+
+	case r.Method == http.MethodDelete:
+		delete(objects, id)
+		delete(f.uploads, id)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeFakeCCMError(w, http.StatusMethodNotAllowed, r.Method+" NOT ALLOWED")
+	}
+}
+
+// splitFakeCCMPath splits a request path into its collection and, when the
+// last segment is numeric, the ID of an object within that collection.
+func splitFakeCCMPath(path string) (string, string) {
+
+	path = "/" + strings.Trim(path, "/")
+	i := strings.LastIndex(path, "/")
+
+	if _, err := strconv.Atoi(path[i+1:]); err == nil {
+		return path[:i], path[i+1:]
+	}
+
+	return path, ""
+}
+
+// decodeFakeCCMBody decodes a JSON request body and also returns it raw.
+// Uploads such as application profiles are not JSON, so their raw body is
+// kept as the object's content.
+func decodeFakeCCMBody(r *http.Request) (map[string]interface{}, []byte, error) {
+
+	body, err := ioutil.ReadAll(r.Body)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Keep the file itself rather than the envelope of a multipart upload.
+	if mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err == nil && strings.HasPrefix(mediaType, "multipart/") {
+
+		part, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).NextPart()
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if body, err = ioutil.ReadAll(part); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	object := map[string]interface{}{}
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		err = json.Unmarshal(body, &object)
+		return object, body, err
+	}
+
+	if json.Unmarshal(body, &object) != nil {
+		object = map[string]interface{}{"content": string(body)}
+	}
+
+	return object, body, nil
+}
+
+func writeFakeCCMJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeFakeCCMError(w http.ResponseWriter, status int, message string) {
+	writeFakeCCMJSON(w, status, map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{
+				"code":    strconv.Itoa(status),
+				"message": message,
+			},
+		},
+	})
+}
@@ -20,7 +20,10 @@ or implied.
 package main
 
 import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func Provider() *schema.Provider {
@@ -28,32 +31,144 @@ func Provider() *schema.Provider {
 		Schema: map[string]*schema.Schema{
 			"username": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("CLOUDCENTER_USERNAME", nil),
-				Description: "Username used to access Cisco Cloudcenter",
+				Description: "Username used to access Cisco Cloudcenter, optionally qualified as <tenant_name>/<username>",
 			},
 			"password": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("CLOUDCENTER_PASSWORD", nil),
 				Description: "Password used to access Cisco Cloudcenter",
 			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDCENTER_API_KEY", nil),
+				Description: "API key used to access Cisco Cloudcenter in place of a password",
+			},
 			"base_url": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("CLOUDCENTER_URL", nil),
 				Description: "URL to the CloudCenter Manager",
 			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("CLOUDCENTER_CA_CERT_FILE", ""),
+				ConflictsWith: []string{"ca_cert_pem"},
+				Description:   "Path to a PEM encoded CA certificate used to verify the CloudCenter Manager",
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_cert_file"},
+				Description:   "PEM encoded CA certificate used to verify the CloudCenter Manager",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDCENTER_INSECURE_SKIP_VERIFY", false),
+				Description: "Skip verification of the CloudCenter Manager's certificate",
+			},
+			"client_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDCENTER_CLIENT_CERT_FILE", ""),
+				Description: "Path to a PEM encoded client certificate for mutual TLS",
+			},
+			"client_key_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDCENTER_CLIENT_KEY_FILE", ""),
+				Description: "Path to the PEM encoded private key of the client certificate",
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDCENTER_PROXY_URL", ""),
+				Description: "URL of the proxy used to reach the CloudCenter Manager",
+			},
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("CLOUDCENTER_REQUEST_TIMEOUT", 60),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Seconds to wait for each API call to the CloudCenter Manager, including retries, 0 for no limit",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("CLOUDCENTER_MAX_RETRIES", 3),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of times a failed request is retried with exponential backoff",
+			},
+			"default_tenant_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("CLOUDCENTER_DEFAULT_TENANT_ID", nil),
+				ConflictsWith: []string{"tenant_name"},
+				Description:   "Tenant used by resources and data sources that do not set tenant_id",
+			},
+			"tenant_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("CLOUDCENTER_TENANT_NAME", nil),
+				ConflictsWith: []string{"default_tenant_id"},
+				Description:   "Name of the tenant used by resources and data sources that do not set tenant_id",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDCENTER_PROFILE", ""),
+				Description: "Profile in the credentials file to take unset connection settings from",
+			},
+			"credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDCENTER_CREDENTIALS_FILE", defaultCredentialsFile),
+				Description: "Path to the credentials file holding named profiles",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"cloudcenter_user":              resourceUser(),
-			"cloudcenter_bundle":            resourceBundle(),
-			"cloudcenter_plan":              resourcePlan(),
-			"cloudcenter_contract":          resourceContract(),
-			"cloudcenter_activationprofile": resourceActivationProfile(),
-			"cloudcenter_image":             resourceImage(),
-			"cloudcenter_group":             resourceGroup(),
-			"cloudcenter_role":              resourceRole(),
+			"cloudcenter_user":                   resourceUser(),
+			"cloudcenter_bundle":                 resourceBundle(),
+			"cloudcenter_plan":                   resourcePlan(),
+			"cloudcenter_contract":               resourceContract(),
+			"cloudcenter_activationprofile":      resourceActivationProfile(),
+			"cloudcenter_image":                  resourceImage(),
+			"cloudcenter_group":                  resourceGroup(),
+			"cloudcenter_role":                   resourceRole(),
+			"cloudcenter_tenant":                 resourceTenant(),
+			"cloudcenter_cloud":                  resourceCloud(),
+			"cloudcenter_cloud_region":           resourceCloudRegion(),
+			"cloudcenter_cloud_account":          resourceCloudAccount(),
+			"cloudcenter_deployment_environment": resourceDeploymentEnvironment(),
+			"cloudcenter_application":            resourceApplication(),
+			"cloudcenter_job":                    resourceJob(),
+			"cloudcenter_deployment_state":       resourceDeploymentState(),
+			"cloudcenter_action":                 resourceAction(),
+			"cloudcenter_tag":                    resourceTag(),
+			"cloudcenter_image_mapping":          resourceImageMapping(),
+			"cloudcenter_instance_type":          resourceInstanceType(),
+			"cloudcenter_project":                resourceProject(),
+			"cloudcenter_project_phase":          resourceProjectPhase(),
+			"cloudcenter_service":                resourceService(),
+			"cloudcenter_repository":             resourceRepository(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"cloudcenter_user":              dataSourceUser(),
+			"cloudcenter_group":             dataSourceGroup(),
+			"cloudcenter_role":              dataSourceRole(),
+			"cloudcenter_plan":              dataSourcePlan(),
+			"cloudcenter_bundle":            dataSourceBundle(),
+			"cloudcenter_contract":          dataSourceContract(),
+			"cloudcenter_activationprofile": dataSourceActivationProfile(),
+			"cloudcenter_deployment":        dataSourceDeployment(),
+			"cloudcenter_instance_type":     dataSourceInstanceType(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
@@ -61,10 +176,123 @@ func Provider() *schema.Provider {
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config := &Config{
-		Username: d.Get("username").(string),
-		Password: d.Get("password").(string),
-		Base_url: d.Get("base_url").(string),
+		Username:           d.Get("username").(string),
+		Password:           d.Get("password").(string),
+		ApiKey:             d.Get("api_key").(string),
+		Base_url:           d.Get("base_url").(string),
+		CACertFile:         d.Get("ca_cert_file").(string),
+		CACertPEM:          d.Get("ca_cert_pem").(string),
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		ClientCertFile:     d.Get("client_cert_file").(string),
+		ClientKeyFile:      d.Get("client_key_file").(string),
+		ProxyURL:           d.Get("proxy_url").(string),
+		RequestTimeout:     d.Get("request_timeout").(int),
+		MaxRetries:         d.Get("max_retries").(int),
+		DefaultTenantId:    d.Get("default_tenant_id").(string),
+		TenantName:         d.Get("tenant_name").(string),
+	}
+
+	if profile := d.Get("profile").(string); profile != "" {
+
+		settings, err := loadCredentialsProfile(d.Get("credentials_file").(string), profile)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := config.applyProfile(settings); err != nil {
+			return nil, err
+		}
+	}
+
+	client, err := config.Client()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &providerMeta{
+		client:          client,
+		defaultTenantId: config.DefaultTenantId,
+	}, nil
+}
+
+// providerMeta is handed to every resource and data source as their meta.
+type providerMeta struct {
+	client          *cloudcenter.Client
+	defaultTenantId string
+}
+
+// setDefaultTenantId fills in tenant_id from the provider's default tenant
+// when the configuration leaves it out.
+func setDefaultTenantId(d *schema.ResourceData, m interface{}) error {
+
+	if d.Get("tenant_id").(string) != "" {
+		return nil
+	}
+
+	tenant_id := m.(*providerMeta).defaultTenantId
+
+	if tenant_id == "" {
+		return errors.New("tenant_id MUST BE SET WHEN THE PROVIDER HAS NO default_tenant_id OR tenant_name")
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
+
+// setResourceDefaultTenantId is setDefaultTenantId for resources, which also
+// record in default_tenant_id the default their tenant was taken from. It is
+// left empty when tenant_id is set in the configuration.
+func setResourceDefaultTenantId(d *schema.ResourceData, m interface{}) error {
+
+	default_tenant_id := ""
+
+	if tenant_id := d.Get("tenant_id").(string); tenant_id == "" || tenant_id == d.Get("default_tenant_id").(string) {
+		default_tenant_id = m.(*providerMeta).defaultTenantId
+	}
+
+	if err := setDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	// Leave default_tenant_id unset rather than empty, as an import does.
+	if default_tenant_id == "" && d.Get("default_tenant_id").(string) == "" {
+		return nil
+	}
+
+	if err := d.Set("default_tenant_id", default_tenant_id); err != nil {
+		return errors.New("CANNOT SET DEFAULT TENANT ID")
+	}
+
+	return nil
+}
+
+// customizeDiffTenantId moves an object whose tenant was taken from the
+// provider's default tenant to the new default when that default changes,
+// which replaces the object as any change of tenant_id does. A diff cannot
+// tell whether tenant_id was left out of the configuration, so this relies on
+// default_tenant_id instead.
+func customizeDiffTenantId(d *schema.ResourceDiff, m interface{}) error {
+
+	tenant_id := d.Get("tenant_id").(string)
+	previous_default := d.Get("default_tenant_id").(string)
+	default_tenant_id := m.(*providerMeta).defaultTenantId
+
+	if previous_default == "" || tenant_id != previous_default || default_tenant_id == "" || default_tenant_id == previous_default {
+		return nil
+	}
+
+	if err := d.SetNew("tenant_id", default_tenant_id); err != nil {
+		return err
+	}
+
+	if err := d.SetNew("default_tenant_id", default_tenant_id); err != nil {
+		return err
 	}
 
-	return config.Client(), nil
+	return d.ForceNew("tenant_id")
 }
@@ -0,0 +1,302 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"regexp"
+	"testing"
+)
+
+var testAccProvider *schema.Provider
+var testAccProviders map[string]terraform.ResourceProvider
+var testAccCCM *fakeCCM
+
+func TestMain(m *testing.M) {
+
+	testAccCCM = newFakeCCM()
+
+	os.Setenv("CLOUDCENTER_URL", testAccCCM.server.URL)
+	os.Setenv("CLOUDCENTER_USERNAME", "admin")
+	os.Setenv("CLOUDCENTER_PASSWORD", "password")
+
+	// Injected faults are one-off, so retries would hide them from the tests
+	// that expect API errors to surface.
+	os.Setenv("CLOUDCENTER_MAX_RETRIES", "0")
+
+	testAccProvider = Provider()
+	testAccProviders = map[string]terraform.ResourceProvider{
+		"cloudcenter": testAccProvider,
+	}
+
+	code := m.Run()
+
+	testAccCCM.server.Close()
+
+	os.Exit(code)
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccCloudCenterProvider_defaultTenantId(t *testing.T) {
+	var group_id, explicit_group_id string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_group", "group_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterProviderDefaultTenantConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_group.group", "group_id"),
+					testAccCheckId("cloudcenter_group.group", "group_id", &group_id),
+					testAccCheckId("cloudcenter_group.explicit", "group_id", &explicit_group_id),
+					resource.TestCheckResourceAttr("cloudcenter_group.group", "tenant_id", "1"),
+					resource.TestCheckResourceAttr("cloudcenter_group.group", "default_tenant_id", "1"),
+					resource.TestCheckNoResourceAttr("cloudcenter_group.explicit", "default_tenant_id"),
+				),
+			},
+			{
+				// Changing the default changes the effective tenant of the
+				// group that leaves tenant_id out, which replaces it.
+				Config:             testAccCloudCenterProviderDefaultTenantConfig("2"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCloudCenterProviderDefaultTenantConfig("2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_group.group", "group_id"),
+					testAccCheckReplaced("cloudcenter_group.group", "group_id", &group_id),
+					resource.TestCheckResourceAttr("cloudcenter_group.group", "tenant_id", "2"),
+					resource.TestCheckResourceAttr("cloudcenter_group.group", "default_tenant_id", "2"),
+					resource.TestCheckResourceAttrPtr("cloudcenter_group.explicit", "group_id", &explicit_group_id),
+					resource.TestCheckResourceAttr("cloudcenter_group.explicit", "tenant_id", "1"),
+				),
+			},
+			{
+				Config:   testAccCloudCenterProviderDefaultTenantConfig("2"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterProviderDefaultTenantConfig(tenantId string) string {
+	return fmt.Sprintf(`
+provider "cloudcenter" {
+    default_tenant_id = "%s"
+}
+
+resource "cloudcenter_group" "group" {
+    group_name  = "terraform-default-tenant"
+    description = "Tenant taken from the provider"
+
+    users {
+        user_id = "2"
+    }
+
+    roles {
+        role_id = "3"
+    }
+}
+
+resource "cloudcenter_group" "explicit" {
+    group_name  = "terraform-explicit-tenant"
+    description = "Tenant set on the group"
+    tenant_id   = "1"
+
+    users {
+        user_id = "2"
+    }
+
+    roles {
+        role_id = "3"
+    }
+}
+`, tenantId)
+}
+
+func TestAccCloudCenterProvider_tenantName(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_group", "group_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterProviderTenantConfig,
+			},
+			{
+				Config: testAccCloudCenterProviderTenantConfig + testAccCloudCenterProviderTenantNameConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_group.group", "group_id"),
+					resource.TestCheckResourceAttrPair("cloudcenter_group.group", "tenant_id", "cloudcenter_tenant.tenant", "tenant_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCloudCenterProviderTenantConfig = `
+resource "cloudcenter_tenant" "tenant" {
+    tenant_name      = "terraform-provider-tenant"
+    contact_email    = "admin@customer.com"
+    parent_tenant_id = "1"
+    domain_name      = "customer.com"
+}
+`
+
+const testAccCloudCenterProviderTenantNameConfig = `
+provider "cloudcenter" {
+    tenant_name = "terraform-provider-tenant"
+}
+
+resource "cloudcenter_group" "group" {
+    group_name = "terraform-tenant-name"
+
+    users {
+        user_id = "2"
+    }
+
+    roles {
+        role_id = "3"
+    }
+}
+`
+
+func TestAccCloudCenterProvider_noTenant(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "cloudcenter_group" "group" {
+    group_name = "terraform-no-tenant"
+
+    users {
+        user_id = "2"
+    }
+
+    roles {
+        role_id = "3"
+    }
+}
+`,
+				ExpectError: regexp.MustCompile("tenant_id MUST BE SET"),
+			},
+		},
+	})
+}
+
+// testAccCheckExists verifies that the object behind the resource's id
+// attribute is stored in the fake CloudCenter Manager.
+func testAccCheckExists(name string, idAttribute string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		id := rs.Primary.Attributes[idAttribute]
+
+		if id == "" {
+			return fmt.Errorf("%s has no %s set", name, idAttribute)
+		}
+
+		if !testAccCCM.exists(id) {
+			return fmt.Errorf("%s %s does not exist in CloudCenter", name, id)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckId records the resource's id attribute for a later step.
+func testAccCheckId(name string, idAttribute string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		*id = s.RootModule().Resources[name].Primary.Attributes[idAttribute]
+		return nil
+	}
+}
+
+// testAccCheckReplaced verifies that the resource now refers to another
+// object than the one recorded by testAccCheckId, and that the recorded one
+// has been removed from the fake CloudCenter Manager.
+func testAccCheckReplaced(name string, idAttribute string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if s.RootModule().Resources[name].Primary.Attributes[idAttribute] == *id {
+			return fmt.Errorf("%s was not replaced", name)
+		}
+
+		if testAccCCM.exists(*id) {
+			return fmt.Errorf("%s %s still exists in CloudCenter", name, *id)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDestroy verifies that every resource of the given type has
+// been removed from the fake CloudCenter Manager.
+func testAccCheckDestroy(resourceType string, idAttribute string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		for _, rs := range s.RootModule().Resources {
+
+			if rs.Type != resourceType {
+				continue
+			}
+
+			id := rs.Primary.Attributes[idAttribute]
+
+			if testAccCCM.exists(id) {
+				return fmt.Errorf("%s %s still exists in CloudCenter", resourceType, id)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDisappears deletes the resource's object directly in the fake
+// CloudCenter Manager, simulating a deletion made in the UI.
+func testAccCheckDisappears(name string, idAttribute string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		testAccCCM.remove(rs.Primary.Attributes[idAttribute])
+
+		return nil
+	}
+}
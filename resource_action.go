@@ -0,0 +1,371 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"log"
+	"strconv"
+)
+
+func resourceAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceActionCreate,
+		Read:   resourceActionRead,
+		Update: resourceActionUpdate,
+		Delete: resourceActionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceActionImport,
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"action_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"action_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"action_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"execution_target": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"VIRTUAL_MACHINE", "DEPLOYMENT"}, false),
+			},
+			"script_source": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"repository_id", "script_path"},
+			},
+			"repository_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"script_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"parameters": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"param_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"display_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default_value": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"required": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"app_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceActionCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	action, err := client.AddAction(expandAction(d))
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + action.Id)
+
+	return setActionResourceData(d, action)
+}
+
+func resourceActionRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTION - TENANT ID INCORRECT")
+	}
+
+	action_id_int, err := strconv.Atoi(d.Get("action_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTION - ACTION ID INCORRECT")
+	}
+
+	action, err := client.GetAction(tenant_id_int, action_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter action %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTION: " + err.Error())
+	}
+
+	return setActionResourceData(d, action)
+}
+
+func resourceActionUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newAction := expandAction(d)
+	newAction.Id = d.Get("action_id").(string)
+
+	action, err := client.UpdateAction(newAction)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setActionResourceData(d, action)
+}
+
+func resourceActionDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTION - TENANT ID INCORRECT")
+	}
+
+	action_id_int, err := strconv.Atoi(d.Get("action_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTION - ACTION ID INCORRECT")
+	}
+
+	err = client.DeleteAction(tenant_id_int, action_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceActionImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, action_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("action_id", action_id); err != nil {
+		return nil, errors.New("CANNOT SET ACTION ID")
+	}
+
+	if err := resourceActionRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT ACTION: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandAction(d *schema.ResourceData) *cloudcenter.Action {
+
+	var actionParameters []cloudcenter.ActionParameter
+
+	for _, parameter := range d.Get("parameters").([]interface{}) {
+
+		p, _ := parameter.(map[string]interface{})
+
+		newActionParameter := cloudcenter.ActionParameter{
+			ParamName:    p["param_name"].(string),
+			DisplayName:  p["display_name"].(string),
+			DefaultValue: p["default_value"].(string),
+			Required:     p["required"].(bool),
+		}
+
+		actionParameters = append(actionParameters, newActionParameter)
+
+	}
+
+	appIds := []string{}
+	for _, appId := range d.Get("app_ids").([]interface{}) {
+		appIds = append(appIds, appId.(string))
+	}
+
+	return &cloudcenter.Action{
+
+		TenantId:         d.Get("tenant_id").(string),
+		Name:             d.Get("action_name").(string),
+		Description:      d.Get("description").(string),
+		ActionType:       d.Get("action_type").(string),
+		ExecutionTarget:  d.Get("execution_target").(string),
+		ScriptSource:     d.Get("script_source").(string),
+		RepositoryId:     d.Get("repository_id").(string),
+		ScriptPath:       d.Get("script_path").(string),
+		Enabled:          d.Get("enabled").(bool),
+		ActionParameters: actionParameters,
+		AppIds:           appIds,
+		Users:            expandUsers(d.Get("users").([]interface{})),
+		Groups:           expandGroups(d.Get("groups").([]interface{})),
+	}
+}
+
+func setActionResourceData(d *schema.ResourceData, u *cloudcenter.Action) error {
+
+	parameters := make([]interface{}, 0, len(u.ActionParameters))
+
+	for _, parameter := range u.ActionParameters {
+		parameters = append(parameters, map[string]interface{}{
+			"param_name":    parameter.ParamName,
+			"display_name":  parameter.DisplayName,
+			"default_value": parameter.DefaultValue,
+			"required":      parameter.Required,
+		})
+	}
+
+	if err := d.Set("action_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("action_name", u.Name); err != nil {
+		return errors.New("CANNOT SET ACTION NAME")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("description", u.Description); err != nil {
+		return errors.New("CANNOT SET DESCRIPTION")
+	}
+	if err := d.Set("action_type", u.ActionType); err != nil {
+		return errors.New("CANNOT SET ACTION TYPE")
+	}
+	if err := d.Set("execution_target", u.ExecutionTarget); err != nil {
+		return errors.New("CANNOT SET EXECUTION TARGET")
+	}
+	if err := d.Set("script_source", u.ScriptSource); err != nil {
+		return errors.New("CANNOT SET SCRIPT SOURCE")
+	}
+	if err := d.Set("repository_id", u.RepositoryId); err != nil {
+		return errors.New("CANNOT SET REPOSITORY ID")
+	}
+	if err := d.Set("script_path", u.ScriptPath); err != nil {
+		return errors.New("CANNOT SET SCRIPT PATH")
+	}
+	if err := d.Set("enabled", u.Enabled); err != nil {
+		return errors.New("CANNOT SET ENABLED")
+	}
+	if err := d.Set("parameters", parameters); err != nil {
+		return errors.New("CANNOT SET PARAMETERS")
+	}
+	if err := d.Set("app_ids", u.AppIds); err != nil {
+		return errors.New("CANNOT SET APP IDS")
+	}
+	if err := d.Set("users", flattenUsers(u.Users)); err != nil {
+		return errors.New("CANNOT SET USERS")
+	}
+	if err := d.Set("groups", flattenGroups(u.Groups)); err != nil {
+		return errors.New("CANNOT SET GROUPS")
+	}
+	if err := d.Set("tenant_id", u.TenantId); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
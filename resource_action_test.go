@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterAction_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_action", "action_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterActionConfig("terraform-action", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_action.action", "action_id"),
+					resource.TestCheckResourceAttr("cloudcenter_action.action", "action_name", "terraform-action"),
+					resource.TestCheckResourceAttr("cloudcenter_action.action", "description", "First"),
+				),
+			},
+			{
+				Config: testAccCloudCenterActionConfig("terraform-action", "Second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_action.action", "action_id"),
+					resource.TestCheckResourceAttr("cloudcenter_action.action", "description", "Second"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_action.action",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterAction_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_action", "action_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterActionConfig("terraform-action", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_action.action", "action_id"),
+					testAccCheckDisappears("cloudcenter_action.action", "action_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterActionConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_action" "action" {
+    action_name      = "%s"
+    description      = "%s"
+    action_type      = "EXECUTE_COMMAND"
+    execution_target = "VIRTUAL_MACHINE"
+    script_source    = "echo hello"
+    enabled          = true
+    app_ids          = ["10"]
+    tenant_id        = "1"
+
+    parameters {
+        param_name    = "greeting"
+        display_name  = "Greeting"
+        default_value = "hello"
+    }
+
+    groups {
+        group_id = "3"
+    }
+}
+`, name, description)
+}
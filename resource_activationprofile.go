@@ -22,6 +22,7 @@ import (
 	"errors"
 	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
 	"github.com/hashicorp/terraform/helper/schema"
+	"log"
 	"strconv"
 )
 
@@ -32,6 +33,26 @@ func resourceActivationProfile() *schema.Resource {
 		Update: resourceActivationProfileUpdate,
 		Delete: resourceActivationProfileDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceActivationProfileImport,
+		},
+
+		SchemaVersion: 2,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceActivationProfileV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceActivationProfileStateUpgradeV0,
+				Version: 0,
+			},
+			{
+				Type:    resourceActivationProfileV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceActivationProfileStateUpgradeV1,
+				Version: 1,
+			},
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
 		Schema: map[string]*schema.Schema{
 			"activation_profile_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -42,8 +63,14 @@ func resourceActivationProfile() *schema.Resource {
 				Required: true,
 			},
 			"tenant_id": &schema.Schema{
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"description": &schema.Schema{
 				Type:     schema.TypeString,
@@ -91,12 +118,17 @@ func resourceActivationProfile() *schema.Resource {
 
 func resourceActivationProfileCreate(d *schema.ResourceData, m interface{}) error {
 
-	activation_profile_name := d.Get("activation_profile_name").(string)
-	tenant_id := strconv.Itoa(d.Get("tenant_id").(int))
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
 
-	d.SetId(tenant_id + ":" + activation_profile_name)
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
 
-	client := m.(*cloudcenter.Client)
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTIVATION PROFILE - TENANT ID INCORRECT")
+	}
 
 	var activateRegions []cloudcenter.ActivateRegion
 
@@ -118,7 +150,7 @@ func resourceActivationProfileCreate(d *schema.ResourceData, m interface{}) erro
 
 		Name:        d.Get("activation_profile_name").(string),
 		Description: d.Get("description").(string),
-		TenantId:    d.Get("tenant_id").(int),
+		TenantId:    tenant_id_int,
 		PlanId:      d.Get("plan_id").(string),
 		BundleId:    d.Get("bundle_id").(string),
 		ContractId:  d.Get("contract_id").(string),
@@ -135,13 +167,19 @@ func resourceActivationProfileCreate(d *schema.ResourceData, m interface{}) erro
 		return errors.New(err.Error())
 	}
 
+	d.SetId(d.Get("tenant_id").(string) + ":" + activationProfile.Id)
+
 	return setActivationProfileResourceData(d, activationProfile)
 }
 
 func resourceActivationProfileRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
-	tenant_id_int := d.Get("tenant_id").(int)
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTIVATION PROFILE - TENANT ID INCORRECT")
+	}
 
 	activation_profile_id_int, err := strconv.Atoi(d.Get("activation_profile_id").(string))
 
@@ -152,7 +190,12 @@ func resourceActivationProfileRead(d *schema.ResourceData, m interface{}) error
 	activationProfile, err := client.GetActivationProfile(tenant_id_int, activation_profile_id_int)
 
 	if err != nil {
-		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTIVATION PROFILE")
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter activation profile %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTIVATION PROFILE: " + err.Error())
 	}
 
 	return setActivationProfileResourceData(d, activationProfile)
@@ -160,7 +203,13 @@ func resourceActivationProfileRead(d *schema.ResourceData, m interface{}) error
 
 func resourceActivationProfileUpdate(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTIVATION PROFILE - TENANT ID INCORRECT")
+	}
 
 	var activateRegions []cloudcenter.ActivateRegion
 
@@ -183,7 +232,7 @@ func resourceActivationProfileUpdate(d *schema.ResourceData, m interface{}) erro
 		Id:          d.Get("activation_profile_id").(string),
 		Name:        d.Get("activation_profile_name").(string),
 		Description: d.Get("description").(string),
-		TenantId:    d.Get("tenant_id").(int),
+		TenantId:    tenant_id_int,
 		PlanId:      d.Get("plan_id").(string),
 		BundleId:    d.Get("bundle_id").(string),
 		ContractId:  d.Get("contract_id").(string),
@@ -205,9 +254,13 @@ func resourceActivationProfileUpdate(d *schema.ResourceData, m interface{}) erro
 
 func resourceActivationProfileDelete(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
-	tenant_id_int := d.Get("tenant_id").(int)
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ACTIVATION PROFILE - TENANT ID INCORRECT")
+	}
 
 	activation_profile_id_int, err := strconv.Atoi(d.Get("activation_profile_id").(string))
 
@@ -217,7 +270,7 @@ func resourceActivationProfileDelete(d *schema.ResourceData, m interface{}) erro
 
 	err = client.DeleteActivationProfile(tenant_id_int, activation_profile_id_int)
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return errors.New(err.Error())
 	}
 
@@ -225,6 +278,32 @@ func resourceActivationProfileDelete(d *schema.ResourceData, m interface{}) erro
 	return nil
 }
 
+func resourceActivationProfileImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, activation_profile_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("activation_profile_id", activation_profile_id); err != nil {
+		return nil, errors.New("CANNOT SET ACTIVATION PROFILE ID")
+	}
+
+	if err := resourceActivationProfileRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT ACTIVATION PROFILE: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setActivationProfileResourceData(d *schema.ResourceData, u *cloudcenter.ActivationProfile) error {
 
 	if err := d.Set("activation_profile_id", u.Id); err != nil {
@@ -236,7 +315,7 @@ func setActivationProfileResourceData(d *schema.ResourceData, u *cloudcenter.Act
 	if err := d.Set("description", u.Description); err != nil {
 		return errors.New("CANNOT SET DESCRIPTION")
 	}
-	if err := d.Set("tenant_id", u.TenantId); err != nil {
+	if err := d.Set("tenant_id", strconv.Itoa(u.TenantId)); err != nil {
 		return errors.New("CANNOT SET TENANT ID")
 	}
 	if err := d.Set("plan_id", u.PlanId); err != nil {
@@ -252,7 +331,7 @@ func setActivationProfileResourceData(d *schema.ResourceData, u *cloudcenter.Act
 		return errors.New("CANNOT SET DEPLOYMENT ENVIRONMENT ID")
 	}
 
-	if err := d.Set("activate_regions", d.Get("activate_regions").([]interface{})); err != nil {
+	if err := d.Set("activate_regions", flattenActivateRegions(u.ActivateRegions)); err != nil {
 		return errors.New("CANNOT SET VALUE - ACTIVATE REGIONS")
 	}
 	if err := d.Set("agree_to_contract", u.AgreeToContract); err != nil {
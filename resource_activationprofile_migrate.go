@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceActivationProfileV0 is the schema of cloudcenter_activationprofile at schema version 0. It
+// must not be changed, as it is used to decode state written by older
+// versions of the provider.
+func resourceActivationProfileV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"activation_profile_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"activation_profile_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"plan_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"bundle_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"contract_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"deployment_environment_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"activate_regions": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"agree_to_contract": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"send_activation_email": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceActivationProfileStateUpgradeV0 rewrites the ID to <tenant_id>:<activation_profile_id>.
+// State created before version 1 used <tenant_id>:<name> as the ID.
+func resourceActivationProfileStateUpgradeV0(rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+
+	activation_profile_id, _ := rawState["activation_profile_id"].(string)
+
+	if activation_profile_id == "" {
+		return rawState, nil
+	}
+
+	rawState["id"] = rawStateString(rawState["tenant_id"]) + ":" + activation_profile_id
+
+	return rawState, nil
+}
+
+// resourceActivationProfileV1 is the schema of cloudcenter_activationprofile at schema version 1,
+// which held tenant_id as a number. It must not be changed.
+func resourceActivationProfileV1() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"activation_profile_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"activation_profile_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"plan_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"bundle_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"contract_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"deployment_environment_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"activate_regions": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"agree_to_contract": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"send_activation_email": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceActivationProfileStateUpgradeV1 converts tenant_id to a string, as
+// used by every other resource.
+func resourceActivationProfileStateUpgradeV1(rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+
+	rawState["tenant_id"] = rawStateString(rawState["tenant_id"])
+
+	return rawState, nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterActivationProfile_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_activationprofile", "activation_profile_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterActivationProfileConfig("terraform-activationprofile", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_activationprofile.activationprofile", "activation_profile_id"),
+					resource.TestCheckResourceAttr("cloudcenter_activationprofile.activationprofile", "activation_profile_name", "terraform-activationprofile"),
+					resource.TestCheckResourceAttr("cloudcenter_activationprofile.activationprofile", "description", "First"),
+				),
+			},
+			{
+				Config: testAccCloudCenterActivationProfileConfig("terraform-activationprofile", "Second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_activationprofile.activationprofile", "activation_profile_id"),
+					resource.TestCheckResourceAttr("cloudcenter_activationprofile.activationprofile", "description", "Second"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_activationprofile.activationprofile",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterActivationProfile_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_activationprofile", "activation_profile_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterActivationProfileConfig("terraform-activationprofile", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_activationprofile.activationprofile", "activation_profile_id"),
+					testAccCheckDisappears("cloudcenter_activationprofile.activationprofile", "activation_profile_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterActivationProfile_apiErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_activationprofile", "activation_profile_id"),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("POST", "activationProfiles", 409)
+				},
+				Config:      testAccCloudCenterActivationProfileConfig("terraform-activationprofile", "First"),
+				ExpectError: regexp.MustCompile("409"),
+			},
+			{
+				Config: testAccCloudCenterActivationProfileConfig("terraform-activationprofile", "First"),
+				Check:  testAccCheckExists("cloudcenter_activationprofile.activationprofile", "activation_profile_id"),
+			},
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("GET", "activationProfiles", 500)
+				},
+				Config:      testAccCloudCenterActivationProfileConfig("terraform-activationprofile", "First"),
+				ExpectError: regexp.MustCompile("UNABLE TO RETRIEVE DETAILS FOR ACTIVATION PROFILE"),
+			},
+		},
+	})
+}
+
+func testAccCloudCenterActivationProfileConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_activationprofile" "activationprofile" {
+    activation_profile_name = "%s"
+    description             = "%s"
+    tenant_id               = "1"
+    plan_id                 = "4"
+
+    activate_regions {
+        region_id = "5"
+    }
+}
+`, name, description)
+}
+
+func TestResourceActivationProfileStateUpgradeV0(t *testing.T) {
+
+	rawState := map[string]interface{}{
+		"id":                      "1000000:terraform-profile",
+		"activation_profile_id":   "5",
+		"activation_profile_name": "terraform-profile",
+		"tenant_id":               float64(1000000),
+	}
+
+	upgraded, err := resourceActivationProfileStateUpgradeV0(rawState, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if upgraded["id"] != "1000000:5" {
+		t.Errorf("expected ID 1000000:5, got %s", upgraded["id"])
+	}
+}
@@ -0,0 +1,346 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strconv"
+)
+
+// applicationModifiedHash replaces content_hash in state when the app
+// profile has been changed in CloudCenter, forcing it to be imported again.
+const applicationModifiedHash = "modified-outside-terraform"
+
+func resourceApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceApplicationCreate,
+		Read:   resourceApplicationRead,
+		Update: resourceApplicationUpdate,
+		Delete: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceApplicationImport,
+		},
+
+		CustomizeDiff: resourceApplicationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"app_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"app_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"app_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"json"},
+				DiffSuppressFunc: suppressUnchangedApplicationContent,
+			},
+			"json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"file"},
+				DiffSuppressFunc: suppressUnchangedApplicationContent,
+			},
+			"content_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remote_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	file_name, content, err := applicationContent(d.Get("file").(string), d.Get("json").(string))
+
+	if err != nil {
+		return err
+	}
+
+	app, err := client.ImportApp(file_name, content)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + app.Id)
+
+	if err := d.Set("content_hash", contentHash(content)); err != nil {
+		return errors.New("CANNOT SET CONTENT HASH")
+	}
+
+	if err := setApplicationResourceData(d, app); err != nil {
+		return err
+	}
+
+	return resourceApplicationRead(d, m)
+}
+
+func resourceApplicationRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	app_id_int, err := strconv.Atoi(d.Get("app_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR APPLICATION - APP ID INCORRECT")
+	}
+
+	app, err := client.GetApp(app_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter application %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR APPLICATION: " + err.Error())
+	}
+
+	exported, err := client.ExportApp(app_id_int)
+
+	if err != nil {
+		return errors.New("UNABLE TO EXPORT APPLICATION: " + err.Error())
+	}
+
+	remote_hash := contentHash(exported)
+
+	if previous := d.Get("remote_hash").(string); previous != "" && previous != remote_hash {
+		log.Printf("[WARN] CloudCenter application %s was modified outside Terraform", d.Id())
+		if err := d.Set("content_hash", applicationModifiedHash); err != nil {
+			return errors.New("CANNOT SET CONTENT HASH")
+		}
+	}
+
+	if err := d.Set("remote_hash", remote_hash); err != nil {
+		return errors.New("CANNOT SET REMOTE HASH")
+	}
+
+	return setApplicationResourceData(d, app)
+}
+
+// resourceApplicationUpdate only runs when file or json change without the
+// app profile itself changing, e.g. when moving the profile from json into a
+// file. A changed profile forces a new import through content_hash.
+func resourceApplicationUpdate(d *schema.ResourceData, m interface{}) error {
+
+	_, content, err := applicationContent(d.Get("file").(string), d.Get("json").(string))
+
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("content_hash", contentHash(content)); err != nil {
+		return errors.New("CANNOT SET CONTENT HASH")
+	}
+
+	return resourceApplicationRead(d, m)
+}
+
+func resourceApplicationDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	app_id_int, err := strconv.Atoi(d.Get("app_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR APPLICATION - APP ID INCORRECT")
+	}
+
+	err = client.DeleteApp(app_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceApplicationImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, app_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("app_id", app_id); err != nil {
+		return nil, errors.New("CANNOT SET APP ID")
+	}
+
+	if err := resourceApplicationRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT APPLICATION: NOT FOUND")
+	}
+
+	// Adopt the app profile as it is in CloudCenter, so that a configuration
+	// holding the same profile plans no changes after the import.
+	app_id_int, _ := strconv.Atoi(app_id)
+
+	exported, err := m.(*providerMeta).client.ExportApp(app_id_int)
+
+	if err != nil {
+		return nil, errors.New("UNABLE TO EXPORT APPLICATION: " + err.Error())
+	}
+
+	if err := d.Set("json", string(exported)); err != nil {
+		return nil, errors.New("CANNOT SET JSON")
+	}
+	if err := d.Set("content_hash", contentHash(exported)); err != nil {
+		return nil, errors.New("CANNOT SET CONTENT HASH")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// suppressUnchangedApplicationContent hides changes to file and json that
+// leave the app profile itself unchanged, such as a file being moved.
+func suppressUnchangedApplicationContent(k, old, new string, d *schema.ResourceData) bool {
+
+	if d.Id() == "" {
+		return false
+	}
+
+	_, content, err := applicationContent(d.Get("file").(string), d.Get("json").(string))
+
+	return err == nil && contentHash(content) == d.Get("content_hash").(string)
+}
+
+// resourceApplicationCustomizeDiff compares the hash of the local app profile
+// with the one recorded when it was imported into CloudCenter, or with the
+// export adopted by terraform import. A mismatch forces a new import.
+func resourceApplicationCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+
+	if err := customizeDiffTenantId(d, m); err != nil {
+		return err
+	}
+
+	if !d.NewValueKnown("file") || !d.NewValueKnown("json") {
+		return nil
+	}
+
+	_, content, err := applicationContent(d.Get("file").(string), d.Get("json").(string))
+
+	if err != nil {
+		return err
+	}
+
+	content_hash := contentHash(content)
+	old, _ := d.GetChange("content_hash")
+
+	if d.Id() == "" || old.(string) == content_hash {
+		return nil
+	}
+
+	if err := d.SetNew("content_hash", content_hash); err != nil {
+		return err
+	}
+
+	return d.ForceNew("content_hash")
+}
+
+func setApplicationResourceData(d *schema.ResourceData, u *cloudcenter.App) error {
+
+	if err := d.Set("app_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("app_name", u.Name); err != nil {
+		return errors.New("CANNOT SET APP NAME")
+	}
+	if err := d.Set("app_version", u.Version); err != nil {
+		return errors.New("CANNOT SET APP VERSION")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+
+	return nil
+}
+
+// applicationContent returns the file name and content of the app profile,
+// read either from file or taken from the inline json attribute.
+func applicationContent(file string, json string) (string, []byte, error) {
+
+	if file != "" {
+
+		content, err := ioutil.ReadFile(file)
+
+		if err != nil {
+			return "", nil, errors.New("UNABLE TO READ APPLICATION PROFILE: " + err.Error())
+		}
+
+		return filepath.Base(file), content, nil
+	}
+
+	if json != "" {
+		return "app.json", []byte(json), nil
+	}
+
+	return "", nil, errors.New("ONE OF file OR json MUST BE SET")
+}
+
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"testing"
+)
+
+func TestAccCloudCenterApplication_basic(t *testing.T) {
+	var app_id string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_application", "app_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterApplicationConfig("1.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_application.application", "app_id"),
+					testAccCheckApplicationId("cloudcenter_application.application", &app_id),
+					resource.TestCheckResourceAttr("cloudcenter_application.application",
+						"content_hash", contentHash([]byte(testAccCloudCenterApplicationJSON("1.0")))),
+				),
+			},
+			{
+				Config: testAccCloudCenterApplicationConfig("2.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_application.application", "app_id"),
+					testAccCheckApplicationReplaced("cloudcenter_application.application", &app_id),
+					resource.TestCheckResourceAttr("cloudcenter_application.application",
+						"content_hash", contentHash([]byte(testAccCloudCenterApplicationJSON("2.0")))),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_application.application",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateCheck:  testAccCheckApplicationImportPlansNoChanges(testAccCloudCenterApplicationJSON("2.0")),
+			},
+			{
+				Config:             testAccCloudCenterApplicationConfig("2.0"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterApplication_modifiedOutsideTerraform(t *testing.T) {
+	var app_id string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_application", "app_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterApplicationConfig("1.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_application.application", "app_id"),
+					testAccCheckApplicationId("cloudcenter_application.application", &app_id),
+					resource.TestCheckResourceAttr("cloudcenter_application.application",
+						"remote_hash", contentHash([]byte(testAccCloudCenterApplicationJSON("1.0")))),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccCCM.modifyUpload(app_id, testAccCloudCenterApplicationJSON("1.1"))
+				},
+				Config:             testAccCloudCenterApplicationConfig("1.0"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCloudCenterApplicationConfig("1.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_application.application", "app_id"),
+					testAccCheckApplicationReplaced("cloudcenter_application.application", &app_id),
+					resource.TestCheckResourceAttr("cloudcenter_application.application",
+						"content_hash", contentHash([]byte(testAccCloudCenterApplicationJSON("1.0")))),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckApplicationImportPlansNoChanges verifies that an imported
+// application plans no changes against a configuration holding the same app
+// profile, rather than being imported into CloudCenter again.
+func testAccCheckApplicationImportPlansNoChanges(json string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported application, got %d", len(states))
+		}
+
+		if states[0].Attributes["content_hash"] == applicationModifiedHash {
+			return fmt.Errorf("imported application is marked as modified outside Terraform")
+		}
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"json":      json,
+			"tenant_id": "1",
+		})
+
+		diff, err := resourceApplication().Diff(states[0], config, testAccProvider.Meta())
+
+		if err != nil {
+			return err
+		}
+
+		if !diff.Empty() {
+			return fmt.Errorf("expected no changes after import, got: %#v", diff.Attributes)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckApplicationId(name string, app_id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		*app_id = s.RootModule().Resources[name].Primary.Attributes["app_id"]
+		return nil
+	}
+}
+
+func testAccCheckApplicationReplaced(name string, app_id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if s.RootModule().Resources[name].Primary.Attributes["app_id"] == *app_id {
+			return fmt.Errorf("%s was not imported again after its app profile changed", name)
+		}
+		return nil
+	}
+}
+
+func testAccCloudCenterApplicationJSON(version string) string {
+	return fmt.Sprintf(`{"name":"terraform-app","version":"%s","serviceTierApps":[]}`, version)
+}
+
+func testAccCloudCenterApplicationConfig(version string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_application" "application" {
+    json      = %q
+    tenant_id = "1"
+}
+`, testAccCloudCenterApplicationJSON(version))
+}
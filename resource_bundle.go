@@ -23,6 +23,7 @@ import (
 	"errors"
 	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
 	"github.com/hashicorp/terraform/helper/schema"
+	"log"
 	"strconv"
 )
 
@@ -33,6 +34,21 @@ func resourceBundle() *schema.Resource {
 		Update: resourceBundleUpdate,
 		Delete: resourceBundleDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceBundleImport,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceBundleV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceBundleStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
 		Schema: map[string]*schema.Schema{
 			"bundle_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -81,7 +97,13 @@ func resourceBundle() *schema.Resource {
 			},
 			"tenant_id": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 	}
@@ -89,12 +111,11 @@ func resourceBundle() *schema.Resource {
 
 func resourceBundleCreate(d *schema.ResourceData, m interface{}) error {
 
-	bundle_name := d.Get("bundle_name").(string)
-	tenant_id := d.Get("tenant_id").(string)
-
-	d.SetId(tenant_id + ":" + bundle_name)
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	newBundle := cloudcenter.Bundle{
 
@@ -112,11 +133,13 @@ func resourceBundleCreate(d *schema.ResourceData, m interface{}) error {
 		return errors.New(err.Error())
 	}
 
+	d.SetId(d.Get("tenant_id").(string) + ":" + bundle.Id)
+
 	return setBundleResourceData(d, bundle)
 }
 
 func resourceBundleRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
 
@@ -133,7 +156,12 @@ func resourceBundleRead(d *schema.ResourceData, m interface{}) error {
 	bundle, err := client.GetBundle(tenant_id_int, bundle_id_int)
 
 	if err != nil {
-		return errors.New("UNABLE TO RETRIEVE DETAILS FOR BUNDLE")
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter bundle %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR BUNDLE: " + err.Error())
 	}
 
 	return setBundleResourceData(d, bundle)
@@ -141,7 +169,7 @@ func resourceBundleRead(d *schema.ResourceData, m interface{}) error {
 
 func resourceBundleUpdate(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	newBundle := cloudcenter.Bundle{
 
@@ -165,7 +193,7 @@ func resourceBundleUpdate(d *schema.ResourceData, m interface{}) error {
 
 func resourceBundleDelete(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
 
@@ -181,7 +209,7 @@ func resourceBundleDelete(d *schema.ResourceData, m interface{}) error {
 
 	err = client.DeleteBundle(tenant_id_int, bundle_id_int)
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return errors.New(err.Error())
 	}
 
@@ -189,6 +217,32 @@ func resourceBundleDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+func resourceBundleImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, bundle_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("bundle_id", bundle_id); err != nil {
+		return nil, errors.New("CANNOT SET BUNDLE ID")
+	}
+
+	if err := resourceBundleRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT BUNDLE: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setBundleResourceData(d *schema.ResourceData, u *cloudcenter.Bundle) error {
 
 	if err := d.Set("bundle_id", u.Id); err != nil {
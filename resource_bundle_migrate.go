@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceBundleV0 is the schema of cloudcenter_bundle at schema version 0. It
+// must not be changed, as it is used to decode state written by older
+// versions of the provider.
+func resourceBundleV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bundle_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bundle_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"limit": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"price": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"expiration_date": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"expiration_months": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"disabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"show_only_to_admin": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"number_of_users": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+// resourceBundleStateUpgradeV0 rewrites the ID to <tenant_id>:<bundle_id>.
+// State created before version 1 used <tenant_id>:<name> as the ID.
+func resourceBundleStateUpgradeV0(rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+
+	bundle_id, _ := rawState["bundle_id"].(string)
+
+	if bundle_id == "" {
+		return rawState, nil
+	}
+
+	rawState["id"] = rawStateString(rawState["tenant_id"]) + ":" + bundle_id
+
+	return rawState, nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterBundle_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_bundle", "bundle_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterBundleConfig("terraform-bundle", "BUDGET_BUNDLE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_bundle.bundle", "bundle_id"),
+					resource.TestCheckResourceAttr("cloudcenter_bundle.bundle", "bundle_name", "terraform-bundle"),
+					resource.TestCheckResourceAttr("cloudcenter_bundle.bundle", "type", "BUDGET_BUNDLE"),
+				),
+			},
+			{
+				Config: testAccCloudCenterBundleConfig("terraform-bundle", "NODE_HOUR_BUNDLE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_bundle.bundle", "bundle_id"),
+					resource.TestCheckResourceAttr("cloudcenter_bundle.bundle", "type", "NODE_HOUR_BUNDLE"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_bundle.bundle",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterBundle_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_bundle", "bundle_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterBundleConfig("terraform-bundle", "BUDGET_BUNDLE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_bundle.bundle", "bundle_id"),
+					testAccCheckDisappears("cloudcenter_bundle.bundle", "bundle_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterBundle_apiErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_bundle", "bundle_id"),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("POST", "bundles", 409)
+				},
+				Config:      testAccCloudCenterBundleConfig("terraform-bundle", "BUDGET_BUNDLE"),
+				ExpectError: regexp.MustCompile("409"),
+			},
+			{
+				Config: testAccCloudCenterBundleConfig("terraform-bundle", "BUDGET_BUNDLE"),
+				Check:  testAccCheckExists("cloudcenter_bundle.bundle", "bundle_id"),
+			},
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("GET", "bundles", 500)
+				},
+				Config:      testAccCloudCenterBundleConfig("terraform-bundle", "BUDGET_BUNDLE"),
+				ExpectError: regexp.MustCompile("UNABLE TO RETRIEVE DETAILS FOR BUNDLE"),
+			},
+		},
+	})
+}
+
+func testAccCloudCenterBundleConfig(name string, bundleType string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_bundle" "bundle" {
+    bundle_name     = "%s"
+    type            = "%s"
+    tenant_id       = "1"
+    limit           = 100
+    price           = 50
+    expiration_date = 1577836800000
+}
+`, name, bundleType)
+}
+
+func TestResourceBundleStateUpgradeV0(t *testing.T) {
+
+	cases := []struct {
+		name     string
+		rawState map[string]interface{}
+		id       string
+	}{
+		{
+			name: "name based ID",
+			rawState: map[string]interface{}{
+				"id":          "1:terraform-bundle",
+				"bundle_id":   "5",
+				"bundle_name": "terraform-bundle",
+				"tenant_id":   "1",
+			},
+			id: "1:5",
+		},
+		{
+			name: "missing bundle_id",
+			rawState: map[string]interface{}{
+				"id":          "1:terraform-bundle",
+				"bundle_name": "terraform-bundle",
+				"tenant_id":   "1",
+			},
+			id: "1:terraform-bundle",
+		},
+	}
+
+	for _, c := range cases {
+
+		upgraded, err := resourceBundleStateUpgradeV0(c.rawState, nil)
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+
+		if upgraded["id"] != c.id {
+			t.Errorf("%s: expected ID %s, got %s", c.name, c.id, upgraded["id"])
+		}
+	}
+}
@@ -0,0 +1,237 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"strconv"
+)
+
+func resourceCloud() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudCreate,
+		Read:   resourceCloudRead,
+		Update: resourceCloudUpdate,
+		Delete: resourceCloudDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudImport,
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"cloud_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloud_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloud_family": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"public_cloud": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	newCloud := cloudcenter.Cloud{
+
+		TenantId:    d.Get("tenant_id").(string),
+		Name:        d.Get("cloud_name").(string),
+		CloudFamily: d.Get("cloud_family").(string),
+		Description: d.Get("description").(string),
+	}
+
+	cloud, err := client.AddCloud(&newCloud)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + cloud.Id)
+
+	return setCloudResourceData(d, cloud)
+}
+
+func resourceCloudRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD - TENANT ID INCORRECT")
+	}
+
+	cloud_id_int, err := strconv.Atoi(d.Get("cloud_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD - CLOUD ID INCORRECT")
+	}
+
+	cloud, err := client.GetCloud(tenant_id_int, cloud_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter cloud %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD: " + err.Error())
+	}
+
+	return setCloudResourceData(d, cloud)
+}
+
+func resourceCloudUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newCloud := cloudcenter.Cloud{
+
+		Id:          d.Get("cloud_id").(string),
+		TenantId:    d.Get("tenant_id").(string),
+		Name:        d.Get("cloud_name").(string),
+		CloudFamily: d.Get("cloud_family").(string),
+		Description: d.Get("description").(string),
+	}
+
+	cloud, err := client.UpdateCloud(&newCloud)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setCloudResourceData(d, cloud)
+}
+
+func resourceCloudDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD - TENANT ID INCORRECT")
+	}
+
+	cloud_id_int, err := strconv.Atoi(d.Get("cloud_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD - CLOUD ID INCORRECT")
+	}
+
+	err = client.DeleteCloud(tenant_id_int, cloud_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, cloud_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("cloud_id", cloud_id); err != nil {
+		return nil, errors.New("CANNOT SET CLOUD ID")
+	}
+
+	if err := resourceCloudRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT CLOUD: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setCloudResourceData(d *schema.ResourceData, u *cloudcenter.Cloud) error {
+
+	if err := d.Set("cloud_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("cloud_name", u.Name); err != nil {
+		return errors.New("CANNOT SET CLOUD NAME")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("cloud_family", u.CloudFamily); err != nil {
+		return errors.New("CANNOT SET CLOUD FAMILY")
+	}
+	if err := d.Set("description", u.Description); err != nil {
+		return errors.New("CANNOT SET DESCRIPTION")
+	}
+	if err := d.Set("public_cloud", u.PublicCloud); err != nil {
+		return errors.New("CANNOT SET PUBLIC CLOUD")
+	}
+	if err := d.Set("tenant_id", u.TenantId); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
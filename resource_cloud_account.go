@@ -0,0 +1,329 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"strconv"
+)
+
+func resourceCloudAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudAccountCreate,
+		Read:   resourceCloudAccountRead,
+		Update: resourceCloudAccountUpdate,
+		Delete: resourceCloudAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudAccountImport,
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"cloud_account_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloud_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"account_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"account_name": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"account_password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"account_description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"account_properties": &schema.Schema{
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"manage_cost": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"public_visible": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"owner_user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudAccountCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	var accountProperties []cloudcenter.AccountProperty
+
+	for name, value := range d.Get("account_properties").(map[string]interface{}) {
+
+		newAccountProperty := cloudcenter.AccountProperty{
+			Name:  name,
+			Value: value.(string),
+		}
+
+		accountProperties = append(accountProperties, newAccountProperty)
+
+	}
+
+	newCloudAccount := cloudcenter.CloudAccount{
+
+		TenantId:           d.Get("tenant_id").(string),
+		CloudId:            d.Get("cloud_id").(string),
+		DisplayName:        d.Get("display_name").(string),
+		AccountId:          d.Get("account_id").(string),
+		AccountName:        d.Get("account_name").(string),
+		AccountPassword:    d.Get("account_password").(string),
+		AccountDescription: d.Get("account_description").(string),
+		AccountProperties:  accountProperties,
+		ManageCost:         d.Get("manage_cost").(bool),
+		PublicVisible:      d.Get("public_visible").(bool),
+	}
+
+	cloudAccount, err := client.AddCloudAccount(&newCloudAccount)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + d.Get("cloud_id").(string) + ":" + cloudAccount.Id)
+
+	return setCloudAccountResourceData(d, cloudAccount)
+}
+
+func resourceCloudAccountRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD ACCOUNT - TENANT ID INCORRECT")
+	}
+
+	cloud_id_int, err := strconv.Atoi(d.Get("cloud_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD ACCOUNT - CLOUD ID INCORRECT")
+	}
+
+	cloud_account_id_int, err := strconv.Atoi(d.Get("cloud_account_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD ACCOUNT - CLOUD ACCOUNT ID INCORRECT")
+	}
+
+	cloudAccount, err := client.GetCloudAccount(tenant_id_int, cloud_id_int, cloud_account_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter cloud account %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD ACCOUNT: " + err.Error())
+	}
+
+	return setCloudAccountResourceData(d, cloudAccount)
+}
+
+func resourceCloudAccountUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	var accountProperties []cloudcenter.AccountProperty
+
+	for name, value := range d.Get("account_properties").(map[string]interface{}) {
+
+		newAccountProperty := cloudcenter.AccountProperty{
+			Name:  name,
+			Value: value.(string),
+		}
+
+		accountProperties = append(accountProperties, newAccountProperty)
+
+	}
+
+	newCloudAccount := cloudcenter.CloudAccount{
+
+		Id:                 d.Get("cloud_account_id").(string),
+		TenantId:           d.Get("tenant_id").(string),
+		CloudId:            d.Get("cloud_id").(string),
+		DisplayName:        d.Get("display_name").(string),
+		AccountId:          d.Get("account_id").(string),
+		AccountName:        d.Get("account_name").(string),
+		AccountPassword:    d.Get("account_password").(string),
+		AccountDescription: d.Get("account_description").(string),
+		AccountProperties:  accountProperties,
+		ManageCost:         d.Get("manage_cost").(bool),
+		PublicVisible:      d.Get("public_visible").(bool),
+	}
+
+	cloudAccount, err := client.UpdateCloudAccount(&newCloudAccount)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setCloudAccountResourceData(d, cloudAccount)
+}
+
+func resourceCloudAccountDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD ACCOUNT - TENANT ID INCORRECT")
+	}
+
+	cloud_id_int, err := strconv.Atoi(d.Get("cloud_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD ACCOUNT - CLOUD ID INCORRECT")
+	}
+
+	cloud_account_id_int, err := strconv.Atoi(d.Get("cloud_account_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD ACCOUNT - CLOUD ACCOUNT ID INCORRECT")
+	}
+
+	err = client.DeleteCloudAccount(tenant_id_int, cloud_id_int, cloud_account_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudAccountImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, cloud_id, cloud_account_id, err := parseNestedImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("cloud_id", cloud_id); err != nil {
+		return nil, errors.New("CANNOT SET CLOUD ID")
+	}
+	if err := d.Set("cloud_account_id", cloud_account_id); err != nil {
+		return nil, errors.New("CANNOT SET CLOUD ACCOUNT ID")
+	}
+
+	if err := resourceCloudAccountRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT CLOUD ACCOUNT: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setCloudAccountResourceData leaves the credential attributes untouched, as
+// CloudCenter never returns them.
+func setCloudAccountResourceData(d *schema.ResourceData, u *cloudcenter.CloudAccount) error {
+
+	if err := d.Set("cloud_account_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("cloud_id", u.CloudId); err != nil {
+		return errors.New("CANNOT SET CLOUD ID")
+	}
+	if err := d.Set("display_name", u.DisplayName); err != nil {
+		return errors.New("CANNOT SET DISPLAY NAME")
+	}
+	if err := d.Set("account_id", u.AccountId); err != nil {
+		return errors.New("CANNOT SET ACCOUNT ID")
+	}
+	if err := d.Set("account_description", u.AccountDescription); err != nil {
+		return errors.New("CANNOT SET ACCOUNT DESCRIPTION")
+	}
+	if err := d.Set("manage_cost", u.ManageCost); err != nil {
+		return errors.New("CANNOT SET MANAGE COST")
+	}
+	if err := d.Set("public_visible", u.PublicVisible); err != nil {
+		return errors.New("CANNOT SET PUBLIC VISIBLE")
+	}
+	if err := d.Set("owner_user_id", u.OwnerUserId); err != nil {
+		return errors.New("CANNOT SET OWNER USER ID")
+	}
+	if err := d.Set("tenant_id", u.TenantId); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
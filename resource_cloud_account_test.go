@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterCloudAccount_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_cloud_account", "cloud_account_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterCloudAccountConfig("terraform-account", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_cloud_account.account", "cloud_account_id"),
+					resource.TestCheckResourceAttr("cloudcenter_cloud_account.account", "display_name", "terraform-account"),
+					resource.TestCheckResourceAttr("cloudcenter_cloud_account.account", "account_description", "First"),
+				),
+			},
+			{
+				Config: testAccCloudCenterCloudAccountConfig("terraform-account", "Second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_cloud_account.account", "cloud_account_id"),
+					resource.TestCheckResourceAttr("cloudcenter_cloud_account.account", "account_description", "Second"),
+				),
+			},
+			{
+				ResourceName:            "cloudcenter_cloud_account.account",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"account_name", "account_password", "account_properties"},
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterCloudAccount_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_cloud_account", "cloud_account_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterCloudAccountConfig("terraform-account", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_cloud_account.account", "cloud_account_id"),
+					testAccCheckDisappears("cloudcenter_cloud_account.account", "cloud_account_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterCloudAccountConfig(name string, accountDescription string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_cloud" "cloud" {
+    cloud_name   = "terraform-cloud"
+    cloud_family = "Amazon"
+    tenant_id    = "1"
+}
+
+resource "cloudcenter_cloud_account" "account" {
+    cloud_id            = "${cloudcenter_cloud.cloud.cloud_id}"
+    display_name        = "%s"
+    account_description = "%s"
+    account_id          = "123456789012"
+    account_name        = "AKIAEXAMPLE"
+    account_password    = "secret"
+    tenant_id           = "1"
+
+    account_properties = {
+        AccessSecretKey = "secret"
+    }
+}
+`, name, accountDescription)
+}
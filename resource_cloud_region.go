@@ -0,0 +1,272 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"strconv"
+)
+
+func resourceCloudRegion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudRegionCreate,
+		Read:   resourceCloudRegionRead,
+		Update: resourceCloudRegionUpdate,
+		Delete: resourceCloudRegionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudRegionImport,
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"region_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloud_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"activated": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudRegionCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	newCloudRegion := cloudcenter.CloudRegion{
+
+		TenantId:    d.Get("tenant_id").(string),
+		CloudId:     d.Get("cloud_id").(string),
+		RegionName:  d.Get("region_name").(string),
+		DisplayName: d.Get("display_name").(string),
+		Description: d.Get("description").(string),
+		Enabled:     d.Get("enabled").(bool),
+	}
+
+	cloudRegion, err := client.AddCloudRegion(&newCloudRegion)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + d.Get("cloud_id").(string) + ":" + cloudRegion.Id)
+
+	return setCloudRegionResourceData(d, cloudRegion)
+}
+
+func resourceCloudRegionRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD REGION - TENANT ID INCORRECT")
+	}
+
+	cloud_id_int, err := strconv.Atoi(d.Get("cloud_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD REGION - CLOUD ID INCORRECT")
+	}
+
+	region_id_int, err := strconv.Atoi(d.Get("region_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD REGION - REGION ID INCORRECT")
+	}
+
+	cloudRegion, err := client.GetCloudRegion(tenant_id_int, cloud_id_int, region_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter cloud region %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD REGION: " + err.Error())
+	}
+
+	return setCloudRegionResourceData(d, cloudRegion)
+}
+
+func resourceCloudRegionUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newCloudRegion := cloudcenter.CloudRegion{
+
+		Id:          d.Get("region_id").(string),
+		TenantId:    d.Get("tenant_id").(string),
+		CloudId:     d.Get("cloud_id").(string),
+		RegionName:  d.Get("region_name").(string),
+		DisplayName: d.Get("display_name").(string),
+		Description: d.Get("description").(string),
+		Enabled:     d.Get("enabled").(bool),
+	}
+
+	cloudRegion, err := client.UpdateCloudRegion(&newCloudRegion)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setCloudRegionResourceData(d, cloudRegion)
+}
+
+func resourceCloudRegionDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD REGION - TENANT ID INCORRECT")
+	}
+
+	cloud_id_int, err := strconv.Atoi(d.Get("cloud_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD REGION - CLOUD ID INCORRECT")
+	}
+
+	region_id_int, err := strconv.Atoi(d.Get("region_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CLOUD REGION - REGION ID INCORRECT")
+	}
+
+	err = client.DeleteCloudRegion(tenant_id_int, cloud_id_int, region_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudRegionImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, cloud_id, region_id, err := parseNestedImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("cloud_id", cloud_id); err != nil {
+		return nil, errors.New("CANNOT SET CLOUD ID")
+	}
+	if err := d.Set("region_id", region_id); err != nil {
+		return nil, errors.New("CANNOT SET REGION ID")
+	}
+
+	if err := resourceCloudRegionRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT CLOUD REGION: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setCloudRegionResourceData(d *schema.ResourceData, u *cloudcenter.CloudRegion) error {
+
+	if err := d.Set("region_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("cloud_id", u.CloudId); err != nil {
+		return errors.New("CANNOT SET CLOUD ID")
+	}
+	if err := d.Set("region_name", u.RegionName); err != nil {
+		return errors.New("CANNOT SET REGION NAME")
+	}
+	if err := d.Set("display_name", u.DisplayName); err != nil {
+		return errors.New("CANNOT SET DISPLAY NAME")
+	}
+	if err := d.Set("description", u.Description); err != nil {
+		return errors.New("CANNOT SET DESCRIPTION")
+	}
+	if err := d.Set("enabled", u.Enabled); err != nil {
+		return errors.New("CANNOT SET ENABLED")
+	}
+	if err := d.Set("activated", u.Activated); err != nil {
+		return errors.New("CANNOT SET ACTIVATED")
+	}
+	if err := d.Set("tenant_id", u.TenantId); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterCloudRegion_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_cloud_region", "region_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterCloudRegionConfig("us-east-1", "US East"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_cloud_region.region", "region_id"),
+					resource.TestCheckResourceAttr("cloudcenter_cloud_region.region", "region_name", "us-east-1"),
+					resource.TestCheckResourceAttr("cloudcenter_cloud_region.region", "display_name", "US East"),
+				),
+			},
+			{
+				Config: testAccCloudCenterCloudRegionConfig("us-east-1", "US East (N. Virginia)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_cloud_region.region", "region_id"),
+					resource.TestCheckResourceAttr("cloudcenter_cloud_region.region", "display_name", "US East (N. Virginia)"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_cloud_region.region",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterCloudRegion_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_cloud_region", "region_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterCloudRegionConfig("us-east-1", "US East"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_cloud_region.region", "region_id"),
+					testAccCheckDisappears("cloudcenter_cloud_region.region", "region_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterCloudRegionConfig(name string, displayName string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_cloud" "cloud" {
+    cloud_name   = "terraform-cloud"
+    cloud_family = "Amazon"
+    tenant_id    = "1"
+}
+
+resource "cloudcenter_cloud_region" "region" {
+    cloud_id     = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_name  = "%s"
+    display_name = "%s"
+    tenant_id    = "1"
+}
+`, name, displayName)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterCloud_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_cloud", "cloud_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterCloudConfig("terraform-cloud", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_cloud.cloud", "cloud_id"),
+					resource.TestCheckResourceAttr("cloudcenter_cloud.cloud", "cloud_name", "terraform-cloud"),
+					resource.TestCheckResourceAttr("cloudcenter_cloud.cloud", "description", "First"),
+				),
+			},
+			{
+				Config: testAccCloudCenterCloudConfig("terraform-cloud", "Second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_cloud.cloud", "cloud_id"),
+					resource.TestCheckResourceAttr("cloudcenter_cloud.cloud", "description", "Second"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_cloud.cloud",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterCloud_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_cloud", "cloud_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterCloudConfig("terraform-cloud", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_cloud.cloud", "cloud_id"),
+					testAccCheckDisappears("cloudcenter_cloud.cloud", "cloud_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterCloudConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_cloud" "cloud" {
+    cloud_name   = "%s"
+    description  = "%s"
+    cloud_family = "Amazon"
+    tenant_id    = "1"
+}
+`, name, description)
+}
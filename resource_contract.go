@@ -23,6 +23,7 @@ import (
 	"errors"
 	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
 	"github.com/hashicorp/terraform/helper/schema"
+	"log"
 	"strconv"
 )
 
@@ -33,6 +34,21 @@ func resourceContract() *schema.Resource {
 		Update: resourceContractUpdate,
 		Delete: resourceContractDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceContractImport,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceContractV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceContractStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
 		Schema: map[string]*schema.Schema{
 			"contract_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -53,7 +69,13 @@ func resourceContract() *schema.Resource {
 			},
 			"tenant_id": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"length": &schema.Schema{
 				Type:     schema.TypeInt,
@@ -84,12 +106,12 @@ func resourceContract() *schema.Resource {
 }
 
 func resourceContractCreate(d *schema.ResourceData, m interface{}) error {
-	contract_name := d.Get("contract_name").(string)
-	tenant_id := d.Get("tenant_id").(string)
 
-	d.SetId(tenant_id + ":" + contract_name)
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	newContract := cloudcenter.Contract{
 
@@ -107,11 +129,13 @@ func resourceContractCreate(d *schema.ResourceData, m interface{}) error {
 		return errors.New(err.Error())
 	}
 
+	d.SetId(d.Get("tenant_id").(string) + ":" + contract.Id)
+
 	return setContractResourceData(d, contract)
 }
 
 func resourceContractRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
 
@@ -128,7 +152,12 @@ func resourceContractRead(d *schema.ResourceData, m interface{}) error {
 	contract, err := client.GetContract(tenant_id_int, contract_id_int)
 
 	if err != nil {
-		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CONTRACT")
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter contract %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR CONTRACT: " + err.Error())
 	}
 
 	return setContractResourceData(d, contract)
@@ -136,7 +165,7 @@ func resourceContractRead(d *schema.ResourceData, m interface{}) error {
 
 func resourceContractUpdate(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	newContract := cloudcenter.Contract{
 
@@ -160,7 +189,7 @@ func resourceContractUpdate(d *schema.ResourceData, m interface{}) error {
 
 func resourceContractDelete(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
 
@@ -176,7 +205,7 @@ func resourceContractDelete(d *schema.ResourceData, m interface{}) error {
 
 	err = client.DeleteContract(tenant_id_int, contact_id_int)
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return errors.New(err.Error())
 	}
 
@@ -184,6 +213,32 @@ func resourceContractDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+func resourceContractImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, contract_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("contract_id", contract_id); err != nil {
+		return nil, errors.New("CANNOT SET CONTRACT ID")
+	}
+
+	if err := resourceContractRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT CONTRACT: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setContractResourceData(d *schema.ResourceData, u *cloudcenter.Contract) error {
 
 	if err := d.Set("contract_id", u.Id); err != nil {
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterContract_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_contract", "contract_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterContractConfig("terraform-contract", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_contract.contract", "contract_id"),
+					resource.TestCheckResourceAttr("cloudcenter_contract.contract", "contract_name", "terraform-contract"),
+					resource.TestCheckResourceAttr("cloudcenter_contract.contract", "description", "First"),
+				),
+			},
+			{
+				Config: testAccCloudCenterContractConfig("terraform-contract", "Second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_contract.contract", "contract_id"),
+					resource.TestCheckResourceAttr("cloudcenter_contract.contract", "description", "Second"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_contract.contract",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterContract_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_contract", "contract_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterContractConfig("terraform-contract", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_contract.contract", "contract_id"),
+					testAccCheckDisappears("cloudcenter_contract.contract", "contract_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterContract_apiErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_contract", "contract_id"),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("POST", "contracts", 409)
+				},
+				Config:      testAccCloudCenterContractConfig("terraform-contract", "First"),
+				ExpectError: regexp.MustCompile("409"),
+			},
+			{
+				Config: testAccCloudCenterContractConfig("terraform-contract", "First"),
+				Check:  testAccCheckExists("cloudcenter_contract.contract", "contract_id"),
+			},
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("GET", "contracts", 500)
+				},
+				Config:      testAccCloudCenterContractConfig("terraform-contract", "First"),
+				ExpectError: regexp.MustCompile("UNABLE TO RETRIEVE DETAILS FOR CONTRACT"),
+			},
+		},
+	})
+}
+
+func testAccCloudCenterContractConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_contract" "contract" {
+    contract_name = "%s"
+    description   = "%s"
+    tenant_id     = "1"
+    length        = 12
+    terms         = "Terms and conditions"
+    discount_rate = 5
+}
+`, name, description)
+}
+
+func TestResourceContractStateUpgradeV0(t *testing.T) {
+
+	cases := []struct {
+		name     string
+		rawState map[string]interface{}
+		id       string
+	}{
+		{
+			name: "name based ID",
+			rawState: map[string]interface{}{
+				"id":            "1:terraform-contract",
+				"contract_id":   "5",
+				"contract_name": "terraform-contract",
+				"tenant_id":     "1",
+			},
+			id: "1:5",
+		},
+		{
+			name: "missing contract_id",
+			rawState: map[string]interface{}{
+				"id":            "1:terraform-contract",
+				"contract_name": "terraform-contract",
+				"tenant_id":     "1",
+			},
+			id: "1:terraform-contract",
+		},
+	}
+
+	for _, c := range cases {
+
+		upgraded, err := resourceContractStateUpgradeV0(c.rawState, nil)
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+
+		if upgraded["id"] != c.id {
+			t.Errorf("%s: expected ID %s, got %s", c.name, c.id, upgraded["id"])
+		}
+	}
+}
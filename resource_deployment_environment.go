@@ -0,0 +1,372 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"strconv"
+)
+
+func resourceDeploymentEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDeploymentEnvironmentCreate,
+		Read:   resourceDeploymentEnvironmentRead,
+		Update: resourceDeploymentEnvironmentUpdate,
+		Delete: resourceDeploymentEnvironmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDeploymentEnvironmentImport,
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"deployment_environment_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_environment_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"require_approval": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"associated_clouds": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"cloud_account_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"default": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"default_instance_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"network_mappings": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"network_type_id": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"network_id": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"owner_user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDeploymentEnvironmentCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	newEnvironment := cloudcenter.Environment{
+
+		TenantId:         d.Get("tenant_id").(string),
+		Name:             d.Get("deployment_environment_name").(string),
+		Description:      d.Get("description").(string),
+		RequireApproval:  d.Get("require_approval").(bool),
+		AssociatedClouds: expandAssociatedClouds(d.Get("associated_clouds").([]interface{})),
+		Users:            expandUsers(d.Get("users").([]interface{})),
+		Groups:           expandGroups(d.Get("groups").([]interface{})),
+		Tags:             expandStringList(d.Get("tags").([]interface{})),
+	}
+
+	environment, err := client.AddEnvironment(&newEnvironment)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + environment.Id)
+
+	return setDeploymentEnvironmentResourceData(d, environment)
+}
+
+func resourceDeploymentEnvironmentRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	environment_id_int, err := strconv.Atoi(d.Get("deployment_environment_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR DEPLOYMENT ENVIRONMENT - DEPLOYMENT ENVIRONMENT ID INCORRECT")
+	}
+
+	environment, err := client.GetEnvironment(environment_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter deployment environment %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR DEPLOYMENT ENVIRONMENT: " + err.Error())
+	}
+
+	return setDeploymentEnvironmentResourceData(d, environment)
+}
+
+func resourceDeploymentEnvironmentUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newEnvironment := cloudcenter.Environment{
+
+		Id:               d.Get("deployment_environment_id").(string),
+		TenantId:         d.Get("tenant_id").(string),
+		Name:             d.Get("deployment_environment_name").(string),
+		Description:      d.Get("description").(string),
+		RequireApproval:  d.Get("require_approval").(bool),
+		AssociatedClouds: expandAssociatedClouds(d.Get("associated_clouds").([]interface{})),
+		Users:            expandUsers(d.Get("users").([]interface{})),
+		Groups:           expandGroups(d.Get("groups").([]interface{})),
+		Tags:             expandStringList(d.Get("tags").([]interface{})),
+	}
+
+	environment, err := client.UpdateEnvironment(&newEnvironment)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setDeploymentEnvironmentResourceData(d, environment)
+}
+
+func resourceDeploymentEnvironmentDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	environment_id_int, err := strconv.Atoi(d.Get("deployment_environment_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR DEPLOYMENT ENVIRONMENT - DEPLOYMENT ENVIRONMENT ID INCORRECT")
+	}
+
+	err = client.DeleteEnvironment(environment_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDeploymentEnvironmentImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, deployment_environment_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("deployment_environment_id", deployment_environment_id); err != nil {
+		return nil, errors.New("CANNOT SET DEPLOYMENT ENVIRONMENT ID")
+	}
+
+	if err := resourceDeploymentEnvironmentRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT DEPLOYMENT ENVIRONMENT: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setDeploymentEnvironmentResourceData(d *schema.ResourceData, u *cloudcenter.Environment) error {
+
+	if err := d.Set("deployment_environment_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("deployment_environment_name", u.Name); err != nil {
+		return errors.New("CANNOT SET DEPLOYMENT ENVIRONMENT NAME")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("description", u.Description); err != nil {
+		return errors.New("CANNOT SET DESCRIPTION")
+	}
+	if err := d.Set("require_approval", u.RequireApproval); err != nil {
+		return errors.New("CANNOT SET REQUIRE APPROVAL")
+	}
+	if err := d.Set("associated_clouds", flattenAssociatedClouds(u.AssociatedClouds)); err != nil {
+		return errors.New("CANNOT SET ASSOCIATED CLOUDS")
+	}
+	if err := d.Set("users", flattenUsers(u.Users)); err != nil {
+		return errors.New("CANNOT SET USERS")
+	}
+	if err := d.Set("groups", flattenGroups(u.Groups)); err != nil {
+		return errors.New("CANNOT SET GROUPS")
+	}
+	if err := d.Set("tags", u.Tags); err != nil {
+		return errors.New("CANNOT SET TAGS")
+	}
+	if err := d.Set("owner_user_id", u.OwnerUserId); err != nil {
+		return errors.New("CANNOT SET OWNER USER ID")
+	}
+	if err := d.Set("tenant_id", u.TenantId); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
+
+func expandAssociatedClouds(allAssociatedClouds []interface{}) []cloudcenter.AssociatedCloud {
+
+	var associatedClouds []cloudcenter.AssociatedCloud
+
+	for _, associatedCloud := range allAssociatedClouds {
+
+		a, _ := associatedCloud.(map[string]interface{})
+
+		var networkMappings []cloudcenter.NetworkMapping
+
+		for _, networkMapping := range a["network_mappings"].([]interface{}) {
+
+			n, _ := networkMapping.(map[string]interface{})
+
+			networkMappings = append(networkMappings, cloudcenter.NetworkMapping{
+				NetworkTypeId: n["network_type_id"].(string),
+				NetworkId:     n["network_id"].(string),
+			})
+		}
+
+		associatedClouds = append(associatedClouds, cloudcenter.AssociatedCloud{
+			RegionId:            a["region_id"].(string),
+			CloudAccountId:      a["cloud_account_id"].(string),
+			Default:             a["default"].(bool),
+			DefaultInstanceType: a["default_instance_type"].(string),
+			NetworkMappings:     networkMappings,
+		})
+	}
+
+	return associatedClouds
+}
+
+func flattenAssociatedClouds(associatedClouds []cloudcenter.AssociatedCloud) []interface{} {
+
+	result := make([]interface{}, 0, len(associatedClouds))
+
+	for _, associatedCloud := range associatedClouds {
+
+		networkMappings := make([]interface{}, 0, len(associatedCloud.NetworkMappings))
+
+		for _, networkMapping := range associatedCloud.NetworkMappings {
+			networkMappings = append(networkMappings, map[string]interface{}{
+				"network_type_id": networkMapping.NetworkTypeId,
+				"network_id":      networkMapping.NetworkId,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"region_id":             associatedCloud.RegionId,
+			"cloud_account_id":      associatedCloud.CloudAccountId,
+			"default":               associatedCloud.Default,
+			"default_instance_type": associatedCloud.DefaultInstanceType,
+			"network_mappings":      networkMappings,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterDeploymentEnvironment_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_deployment_environment", "deployment_environment_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterDeploymentEnvironmentConfig("terraform-environment", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_deployment_environment.environment", "deployment_environment_id"),
+					resource.TestCheckResourceAttr("cloudcenter_deployment_environment.environment", "deployment_environment_name", "terraform-environment"),
+					resource.TestCheckResourceAttr("cloudcenter_deployment_environment.environment", "description", "First"),
+					resource.TestCheckResourceAttr("cloudcenter_deployment_environment.environment", "tags.0", "production"),
+				),
+			},
+			{
+				Config: testAccCloudCenterDeploymentEnvironmentConfig("terraform-environment", "Second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_deployment_environment.environment", "deployment_environment_id"),
+					resource.TestCheckResourceAttr("cloudcenter_deployment_environment.environment", "description", "Second"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_deployment_environment.environment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterDeploymentEnvironment_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_deployment_environment", "deployment_environment_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterDeploymentEnvironmentConfig("terraform-environment", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_deployment_environment.environment", "deployment_environment_id"),
+					testAccCheckDisappears("cloudcenter_deployment_environment.environment", "deployment_environment_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterDeploymentEnvironmentConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_cloud" "cloud" {
+    cloud_name   = "terraform-cloud"
+    cloud_family = "Amazon"
+    tenant_id    = "1"
+}
+
+resource "cloudcenter_cloud_region" "region" {
+    cloud_id    = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_name = "us-east-1"
+    tenant_id   = "1"
+}
+
+resource "cloudcenter_cloud_account" "account" {
+    cloud_id     = "${cloudcenter_cloud.cloud.cloud_id}"
+    display_name = "terraform-account"
+    tenant_id    = "1"
+}
+
+resource "cloudcenter_deployment_environment" "environment" {
+    deployment_environment_name = "%s"
+    description                 = "%s"
+    tenant_id                   = "1"
+    tags                        = ["production"]
+
+    associated_clouds {
+        region_id             = "${cloudcenter_cloud_region.region.region_id}"
+        cloud_account_id      = "${cloudcenter_cloud_account.account.cloud_account_id}"
+        default               = true
+        default_instance_type = "t2.small"
+
+        network_mappings {
+            network_type_id = "1"
+            network_id      = "vpc-0123456789"
+        }
+    }
+
+    users {
+        user_id = "2"
+    }
+}
+`, name, description)
+}
@@ -0,0 +1,251 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"log"
+	"strconv"
+	"time"
+)
+
+// deploymentStates maps the job statuses CloudCenter settles in to the
+// desired_state values of cloudcenter_deployment_state.
+var deploymentStates = map[string]string{
+	"JobRunning":   "running",
+	"JobSuspended": "suspended",
+	"JobStopped":   "stopped",
+}
+
+func resourceDeploymentState() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDeploymentStateCreate,
+		Read:   resourceDeploymentStateRead,
+		Update: resourceDeploymentStateUpdate,
+		Delete: resourceDeploymentStateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDeploymentStateImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"job_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"desired_state": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"running", "suspended", "stopped"}, false),
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDeploymentStateCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	if err := setDeploymentState(d, m, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + d.Get("job_id").(string))
+
+	return resourceDeploymentStateRead(d, m)
+}
+
+func resourceDeploymentStateRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	job_id_int, err := strconv.Atoi(d.Get("job_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR DEPLOYMENT - JOB ID INCORRECT")
+	}
+
+	job, err := client.GetJob(job_id_int)
+
+	if err != nil {
+		if isNotFound(err) && d.Get("desired_state").(string) == "stopped" {
+			// A terminated job may be purged from CloudCenter straight away.
+			if err := d.Set("status", jobTerminatedStatus); err != nil {
+				return errors.New("CANNOT SET STATUS")
+			}
+			return nil
+		}
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter deployment %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR DEPLOYMENT: " + err.Error())
+	}
+
+	if err := d.Set("status", job.Status); err != nil {
+		return errors.New("CANNOT SET STATUS")
+	}
+
+	if state, ok := deploymentStates[job.Status]; ok {
+		if err := d.Set("desired_state", state); err != nil {
+			return errors.New("CANNOT SET DESIRED STATE")
+		}
+	}
+
+	return nil
+}
+
+func resourceDeploymentStateUpdate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setDeploymentState(d, m, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceDeploymentStateRead(d, m)
+}
+
+// resourceDeploymentStateDelete only stops managing the state of the
+// deployment, the deployment itself is left as it is.
+func resourceDeploymentStateDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func resourceDeploymentStateImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, job_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("job_id", job_id); err != nil {
+		return nil, errors.New("CANNOT SET JOB ID")
+	}
+
+	if err := resourceDeploymentStateRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT DEPLOYMENT: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setDeploymentState suspends, resumes or terminates the deployment so that
+// it matches desired_state, and waits for CloudCenter to finish the action.
+func setDeploymentState(d *schema.ResourceData, m interface{}, timeout time.Duration) error {
+
+	client := m.(*providerMeta).client
+
+	job_id := d.Get("job_id").(string)
+	desired_state := d.Get("desired_state").(string)
+
+	job_id_int, err := strconv.Atoi(job_id)
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR DEPLOYMENT - JOB ID INCORRECT")
+	}
+
+	job, err := client.GetJob(job_id_int)
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR DEPLOYMENT: " + err.Error())
+	}
+
+	if deploymentStates[job.Status] == desired_state {
+		return nil
+	}
+
+	var pending []string
+	var target string
+
+	refresh := jobStatusRefreshFunc(client, job_id_int)
+
+	switch desired_state {
+	case "running":
+		if job.Status == "JobStopped" {
+			return errors.New("DEPLOYMENT " + job_id + " HAS BEEN STOPPED AND CANNOT BE STARTED AGAIN")
+		}
+		err = client.ResumeJob(job_id_int)
+		pending = []string{"JobSuspended", "JobResuming", "JobStarting", "JobInProgress"}
+		target = "JobRunning"
+
+	case "suspended":
+		err = client.SuspendJob(job_id_int)
+		pending = []string{"JobRunning", "JobSuspending"}
+		target = "JobSuspended"
+
+	case "stopped":
+		err = client.TerminateJob(job_id_int)
+		pending = []string{"JobRunning", "JobSuspended", "JobStopping", "JobTerminating"}
+		target = jobTerminatedStatus
+		refresh = jobTerminatedRefreshFunc(client, job_id_int)
+	}
+
+	if err != nil {
+		return errors.New("UNABLE TO CHANGE STATE OF DEPLOYMENT " + job_id + " TO " + desired_state + ": " + err.Error())
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errors.New("DEPLOYMENT " + job_id + " DID NOT REACH STATE " + desired_state + ": " + err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterDeploymentState_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_job", "job_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterDeploymentStateConfig("suspended"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudcenter_deployment_state.state", "desired_state", "suspended"),
+					resource.TestCheckResourceAttr("cloudcenter_deployment_state.state", "status", "JobSuspended"),
+				),
+			},
+			{
+				Config: testAccCloudCenterDeploymentStateConfig("running"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudcenter_deployment_state.state", "desired_state", "running"),
+					resource.TestCheckResourceAttr("cloudcenter_deployment_state.state", "status", "JobRunning"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_deployment_state.state",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCloudCenterDeploymentStateConfig("stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudcenter_deployment_state.state", "desired_state", "stopped"),
+					resource.TestCheckResourceAttr("cloudcenter_deployment_state.state", "status", "JobStopped"),
+				),
+				// The terminated job is removed from state, so the next plan
+				// deploys it again.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterDeploymentState_stoppedAndPurged(t *testing.T) {
+	testAccCCM.purgeOnTerminate(true)
+	defer testAccCCM.purgeOnTerminate(false)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterDeploymentStateConfig("running"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudcenter_deployment_state.state", "status", "JobRunning"),
+				),
+			},
+			{
+				Config: testAccCloudCenterDeploymentStateConfig("stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDestroy("cloudcenter_job", "job_id"),
+					resource.TestCheckResourceAttr("cloudcenter_deployment_state.state", "desired_state", "stopped"),
+					resource.TestCheckResourceAttr("cloudcenter_deployment_state.state", "status", "JobStopped"),
+				),
+				// The purged job is removed from state, so the next plan
+				// deploys it again.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterDeploymentStateConfig(desiredState string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_job" "job" {
+    job_name                  = "terraform-job-state"
+    app_id                    = "10"
+    app_version               = "1.0"
+    deployment_environment_id = "20"
+    tenant_id                 = "1"
+}
+
+resource "cloudcenter_deployment_state" "state" {
+    job_id        = "${cloudcenter_job.job.job_id}"
+    desired_state = "%s"
+    tenant_id     = "1"
+}
+`, desiredState)
+}
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
 	"github.com/hashicorp/terraform/helper/schema"
+	"log"
 	"strconv"
 )
 
@@ -33,6 +34,21 @@ func resourceGroup() *schema.Resource {
 		Update: resourceGroupUpdate,
 		Delete: resourceGroupDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceGroupImport,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceGroupV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceGroupStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
 		Schema: map[string]*schema.Schema{
 			"group_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -89,7 +105,13 @@ func resourceGroup() *schema.Resource {
 			},
 			"tenant_id": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 	}
@@ -97,52 +119,19 @@ func resourceGroup() *schema.Resource {
 
 func resourceGroupCreate(d *schema.ResourceData, m interface{}) error {
 
-	group_name := d.Get("group_name").(string)
-	tenant_id := d.Get("tenant_id").(string)
-
-	d.SetId(tenant_id + ":" + group_name)
-
-	client := m.(*cloudcenter.Client)
-
-	var users []cloudcenter.User
-
-	allUsers := d.Get("users").([]interface{})
-
-	for _, user := range allUsers {
-
-		u, _ := user.(map[string]interface{})
-
-		newUser := cloudcenter.User{
-			Id: u["user_id"].(string),
-		}
-
-		users = append(users, newUser)
-
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
 	}
 
-	var roles []cloudcenter.Role
-
-	allRoles := d.Get("roles").([]interface{})
-
-	for _, role := range allRoles {
-
-		r, _ := role.(map[string]interface{})
-
-		newRole := cloudcenter.Role{
-			Id: r["role_id"].(string),
-		}
-
-		roles = append(roles, newRole)
-
-	}
+	client := m.(*providerMeta).client
 
 	newGroup := cloudcenter.Group{
 
 		TenantId:    d.Get("tenant_id").(string),
 		Name:        d.Get("group_name").(string),
 		Description: d.Get("description").(string),
-		Users:       users,
-		Roles:       roles,
+		Users:       expandUsers(d.Get("users").([]interface{})),
+		Roles:       expandRoles(d.Get("roles").([]interface{})),
 	}
 
 	group, err := client.AddGroup(&newGroup)
@@ -151,11 +140,13 @@ func resourceGroupCreate(d *schema.ResourceData, m interface{}) error {
 		return errors.New(err.Error())
 	}
 
+	d.SetId(d.Get("tenant_id").(string) + ":" + group.Id)
+
 	return setGroupResourceData(d, group)
 }
 
 func resourceGroupRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
 
@@ -172,7 +163,12 @@ func resourceGroupRead(d *schema.ResourceData, m interface{}) error {
 	group, err := client.GetGroup(tenant_id_int, group_id_int)
 
 	if err != nil {
-		return errors.New("UNABLE TO RETRIEVE DETAILS FOR GROUP")
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter group %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR GROUP: " + err.Error())
 	}
 
 	return setGroupResourceData(d, group)
@@ -180,39 +176,7 @@ func resourceGroupRead(d *schema.ResourceData, m interface{}) error {
 
 func resourceGroupUpdate(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
-
-	var users []cloudcenter.User
-
-	allUsers := d.Get("users").([]interface{})
-
-	for _, user := range allUsers {
-
-		u, _ := user.(map[string]interface{})
-
-		newUser := cloudcenter.User{
-			Id: u["user_id"].(string),
-		}
-
-		users = append(users, newUser)
-
-	}
-
-	var roles []cloudcenter.Role
-
-	allRoles := d.Get("roles").([]interface{})
-
-	for _, role := range allRoles {
-
-		r, _ := role.(map[string]interface{})
-
-		newRole := cloudcenter.Role{
-			Id: r["role_id"].(string),
-		}
-
-		roles = append(roles, newRole)
-
-	}
+	client := m.(*providerMeta).client
 
 	newGroup := cloudcenter.Group{
 
@@ -220,8 +184,8 @@ func resourceGroupUpdate(d *schema.ResourceData, m interface{}) error {
 		TenantId:    d.Get("tenant_id").(string),
 		Name:        d.Get("group_name").(string),
 		Description: d.Get("description").(string),
-		Users:       users,
-		Roles:       roles,
+		Users:       expandUsers(d.Get("users").([]interface{})),
+		Roles:       expandRoles(d.Get("roles").([]interface{})),
 	}
 
 	group, err := client.UpdateGroup(&newGroup)
@@ -235,7 +199,7 @@ func resourceGroupUpdate(d *schema.ResourceData, m interface{}) error {
 
 func resourceGroupDelete(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
 
@@ -251,7 +215,7 @@ func resourceGroupDelete(d *schema.ResourceData, m interface{}) error {
 
 	err = client.DeleteGroup(tenant_id_int, group_id_int)
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return errors.New(err.Error())
 	}
 
@@ -259,6 +223,32 @@ func resourceGroupDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+func resourceGroupImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, group_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("group_id", group_id); err != nil {
+		return nil, errors.New("CANNOT SET GROUP ID")
+	}
+
+	if err := resourceGroupRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT GROUP: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setGroupResourceData(d *schema.ResourceData, u *cloudcenter.Group) error {
 
 	if err := d.Set("group_id", u.Id); err != nil {
@@ -273,10 +263,10 @@ func setGroupResourceData(d *schema.ResourceData, u *cloudcenter.Group) error {
 	if err := d.Set("description", u.Description); err != nil {
 		return errors.New("CANNOT SET DESCRIPTION")
 	}
-	if err := d.Set("users", d.Get("users").([]interface{})); err != nil {
+	if err := d.Set("users", flattenUsers(u.Users)); err != nil {
 		return errors.New("CANNOT SET USERS")
 	}
-	if err := d.Set("roles", d.Get("roles").([]interface{})); err != nil {
+	if err := d.Set("roles", flattenRoles(u.Roles)); err != nil {
 		return errors.New("CANNOT SET ROLES")
 	}
 	if err := d.Set("created", u.Created); err != nil {
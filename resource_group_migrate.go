@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceGroupV0 is the schema of cloudcenter_group at schema version 0. It
+// must not be changed, as it is used to decode state written by older
+// versions of the provider.
+func resourceGroupV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"created": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_by_sso": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"roles": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+// resourceGroupStateUpgradeV0 rewrites the ID to <tenant_id>:<group_id>.
+// State created before version 1 used <tenant_id>:<name> as the ID.
+func resourceGroupStateUpgradeV0(rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+
+	group_id, _ := rawState["group_id"].(string)
+
+	if group_id == "" {
+		return rawState, nil
+	}
+
+	rawState["id"] = rawStateString(rawState["tenant_id"]) + ":" + group_id
+
+	return rawState, nil
+}
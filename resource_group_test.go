@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterGroup_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_group", "group_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterGroupConfig("terraform-group", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_group.group", "group_id"),
+					resource.TestCheckResourceAttr("cloudcenter_group.group", "group_name", "terraform-group"),
+					resource.TestCheckResourceAttr("cloudcenter_group.group", "description", "First"),
+				),
+			},
+			{
+				Config: testAccCloudCenterGroupConfig("terraform-group", "Second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_group.group", "group_id"),
+					resource.TestCheckResourceAttr("cloudcenter_group.group", "description", "Second"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_group.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterGroup_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_group", "group_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterGroupConfig("terraform-group", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_group.group", "group_id"),
+					testAccCheckDisappears("cloudcenter_group.group", "group_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterGroup_importNotFound(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_group", "group_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterGroupConfig("terraform-group", "First"),
+				Check:  testAccCheckExists("cloudcenter_group.group", "group_id"),
+			},
+			{
+				ResourceName:  "cloudcenter_group.group",
+				ImportState:   true,
+				ImportStateId: "1:999999",
+				ExpectError:   regexp.MustCompile("CANNOT IMPORT GROUP: NOT FOUND"),
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterGroup_apiErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_group", "group_id"),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("POST", "groups", 409)
+				},
+				Config:      testAccCloudCenterGroupConfig("terraform-group", "First"),
+				ExpectError: regexp.MustCompile("409"),
+			},
+			{
+				Config: testAccCloudCenterGroupConfig("terraform-group", "First"),
+				Check:  testAccCheckExists("cloudcenter_group.group", "group_id"),
+			},
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("GET", "groups", 500)
+				},
+				Config:      testAccCloudCenterGroupConfig("terraform-group", "First"),
+				ExpectError: regexp.MustCompile("UNABLE TO RETRIEVE DETAILS FOR GROUP"),
+			},
+			{
+				PreConfig: func() {
+					testAccCCM.injectFaultMessage("GET", "groups", 500, "BACKEND RETURNED 404 NOT FOUND")
+				},
+				Config:      testAccCloudCenterGroupConfig("terraform-group", "First"),
+				ExpectError: regexp.MustCompile("UNABLE TO RETRIEVE DETAILS FOR GROUP: .*HTTP 500"),
+			},
+		},
+	})
+}
+
+func testAccCloudCenterGroupConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_group" "group" {
+    group_name  = "%s"
+    description = "%s"
+    tenant_id   = "1"
+
+    users {
+        user_id = "2"
+    }
+
+    roles {
+        role_id = "3"
+    }
+}
+`, name, description)
+}
+
+func TestResourceGroupStateUpgradeV0(t *testing.T) {
+
+	cases := []struct {
+		name     string
+		rawState map[string]interface{}
+		id       string
+	}{
+		{
+			name: "name based ID",
+			rawState: map[string]interface{}{
+				"id":         "1:terraform-group",
+				"group_id":   "5",
+				"group_name": "terraform-group",
+				"tenant_id":  "1",
+			},
+			id: "1:5",
+		},
+		{
+			name: "missing group_id",
+			rawState: map[string]interface{}{
+				"id":         "1:terraform-group",
+				"group_name": "terraform-group",
+				"tenant_id":  "1",
+			},
+			id: "1:terraform-group",
+		},
+	}
+
+	for _, c := range cases {
+
+		upgraded, err := resourceGroupStateUpgradeV0(c.rawState, nil)
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+
+		if upgraded["id"] != c.id {
+			t.Errorf("%s: expected ID %s, got %s", c.name, c.id, upgraded["id"])
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
 	"github.com/hashicorp/terraform/helper/schema"
+	"log"
 	"strconv"
 )
 
@@ -32,6 +33,26 @@ func resourceImage() *schema.Resource {
 		Update: resourceImageUpdate,
 		Delete: resourceImageDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceImageImport,
+		},
+
+		SchemaVersion: 2,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceImageV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceImageStateUpgradeV0,
+				Version: 0,
+			},
+			{
+				Type:    resourceImageV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceImageStateUpgradeV1,
+				Version: 1,
+			},
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
 		Schema: map[string]*schema.Schema{
 			"image_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -46,8 +67,14 @@ func resourceImage() *schema.Resource {
 				Computed: true,
 			},
 			"tenant_id": &schema.Schema{
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"internal_image_name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -69,10 +96,11 @@ func resourceImage() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			/*"tags": &schema.Schema{
-				Type:     schema.TypeString,
+			"tags": &schema.Schema{
+				Type:     schema.TypeList,
 				Optional: true,
-			},*/
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"enabled": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -95,12 +123,17 @@ func resourceImage() *schema.Resource {
 
 func resourceImageCreate(d *schema.ResourceData, m interface{}) error {
 
-	image_name := d.Get("image_name").(string)
-	tenant_id := d.Get("tenant_id").(int)
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
 
-	d.SetId(strconv.Itoa(tenant_id) + ":" + image_name)
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
 
-	client := m.(*cloudcenter.Client)
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE - TENANT ID INCORRECT")
+	}
 
 	newImage := cloudcenter.Image{
 
@@ -110,8 +143,9 @@ func resourceImageCreate(d *schema.ResourceData, m interface{}) error {
 		NumOfNICs:   d.Get("num_of_nics").(int),
 		OSName:      d.Get("os_name").(string),
 		Enabled:     d.Get("enabled").(bool),
-		TenantId:    d.Get("tenant_id").(int),
+		TenantId:    tenant_id_int,
 		ImageType:   d.Get("image_type").(string),
+		Tags:        expandStringList(d.Get("tags").([]interface{})),
 	}
 
 	image, err := client.AddImage(&newImage)
@@ -120,11 +154,19 @@ func resourceImageCreate(d *schema.ResourceData, m interface{}) error {
 		return errors.New(err.Error())
 	}
 
+	d.SetId(d.Get("tenant_id").(string) + ":" + image.Id)
+
 	return setImageResourceData(d, image)
 }
 
 func resourceImageRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE - TENANT ID INCORRECT")
+	}
 
 	image_id_int, err := strconv.Atoi(d.Get("image_id").(string))
 
@@ -132,10 +174,15 @@ func resourceImageRead(d *schema.ResourceData, m interface{}) error {
 		return errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE - IMAGE ID INCORRECT")
 	}
 
-	image, err := client.GetImage(d.Get("tenant_id").(int), image_id_int)
+	image, err := client.GetImage(tenant_id_int, image_id_int)
 
 	if err != nil {
-		return errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE")
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter image %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE: " + err.Error())
 	}
 
 	return setImageResourceData(d, image)
@@ -143,7 +190,13 @@ func resourceImageRead(d *schema.ResourceData, m interface{}) error {
 
 func resourceImageUpdate(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE - TENANT ID INCORRECT")
+	}
 
 	newImage := cloudcenter.Image{
 
@@ -154,8 +207,9 @@ func resourceImageUpdate(d *schema.ResourceData, m interface{}) error {
 		NumOfNICs:   d.Get("num_of_nics").(int),
 		OSName:      d.Get("os_name").(string),
 		Enabled:     d.Get("enabled").(bool),
-		TenantId:    d.Get("tenant_id").(int),
+		TenantId:    tenant_id_int,
 		ImageType:   d.Get("image_type").(string),
+		Tags:        expandStringList(d.Get("tags").([]interface{})),
 	}
 
 	image, err := client.UpdateImage(&newImage)
@@ -169,7 +223,13 @@ func resourceImageUpdate(d *schema.ResourceData, m interface{}) error {
 
 func resourceImageDelete(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE - TENANT ID INCORRECT")
+	}
 
 	image_id_int, err := strconv.Atoi(d.Get("image_id").(string))
 
@@ -177,9 +237,9 @@ func resourceImageDelete(d *schema.ResourceData, m interface{}) error {
 		return errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE - IMAGE ID INCORRECT")
 	}
 
-	err = client.DeleteImage(d.Get("tenant_id").(int), image_id_int)
+	err = client.DeleteImage(tenant_id_int, image_id_int)
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return errors.New(err.Error())
 	}
 
@@ -187,6 +247,32 @@ func resourceImageDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+func resourceImageImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, image_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("image_id", image_id); err != nil {
+		return nil, errors.New("CANNOT SET IMAGE ID")
+	}
+
+	if err := resourceImageRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT IMAGE: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setImageResourceData(d *schema.ResourceData, u *cloudcenter.Image) error {
 
 	if err := d.Set("image_id", u.Id); err != nil {
@@ -195,7 +281,7 @@ func setImageResourceData(d *schema.ResourceData, u *cloudcenter.Image) error {
 	if err := d.Set("image_name", u.Name); err != nil {
 		return errors.New("CANNOT SET IMAGE NAME")
 	}
-	if err := d.Set("tenant_id", u.TenantId); err != nil {
+	if err := d.Set("tenant_id", strconv.Itoa(u.TenantId)); err != nil {
 		return errors.New("CANNOT SET TENANT ID")
 	}
 	if err := d.Set("resource", u.Resource); err != nil {
@@ -216,9 +302,9 @@ func setImageResourceData(d *schema.ResourceData, u *cloudcenter.Image) error {
 	if err := d.Set("os_name", u.OSName); err != nil {
 		return errors.New("CANNOT SET OS NAME")
 	}
-	/*if err := d.Set("tags", u.Tags); err != nil {
+	if err := d.Set("tags", u.Tags); err != nil {
 		return errors.New("CANNOT SET TAGS")
-	}*/
+	}
 	if err := d.Set("enabled", u.Enabled); err != nil {
 		return errors.New("CANNOT SET ENABLED")
 	}
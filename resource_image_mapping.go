@@ -0,0 +1,276 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"strconv"
+)
+
+func resourceImageMapping() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImageMappingCreate,
+		Read:   resourceImageMappingRead,
+		Update: resourceImageMappingUpdate,
+		Delete: resourceImageMappingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceImageMappingImport,
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"mapping_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cloud_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cloud_image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImageMappingCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	newImageMapping := cloudcenter.ImageMapping{
+
+		TenantId:      d.Get("tenant_id").(string),
+		CloudId:       d.Get("cloud_id").(string),
+		RegionId:      d.Get("region_id").(string),
+		ImageId:       d.Get("image_id").(string),
+		CloudImageId:  d.Get("cloud_image_id").(string),
+		InstanceTypes: expandStringList(d.Get("instance_types").([]interface{})),
+	}
+
+	imageMapping, err := client.AddImageMapping(&newImageMapping)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + d.Get("cloud_id").(string) + ":" + d.Get("region_id").(string) + ":" + imageMapping.Id)
+
+	return setImageMappingResourceData(d, imageMapping)
+}
+
+func resourceImageMappingRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	tenant_id_int, cloud_id_int, region_id_int, mapping_id_int, err := imageMappingIds(d)
+
+	if err != nil {
+		return err
+	}
+
+	imageMapping, err := client.GetImageMapping(tenant_id_int, cloud_id_int, region_id_int, mapping_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter image mapping %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE MAPPING: " + err.Error())
+	}
+
+	return setImageMappingResourceData(d, imageMapping)
+}
+
+func resourceImageMappingUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newImageMapping := cloudcenter.ImageMapping{
+
+		Id:            d.Get("mapping_id").(string),
+		TenantId:      d.Get("tenant_id").(string),
+		CloudId:       d.Get("cloud_id").(string),
+		RegionId:      d.Get("region_id").(string),
+		ImageId:       d.Get("image_id").(string),
+		CloudImageId:  d.Get("cloud_image_id").(string),
+		InstanceTypes: expandStringList(d.Get("instance_types").([]interface{})),
+	}
+
+	imageMapping, err := client.UpdateImageMapping(&newImageMapping)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setImageMappingResourceData(d, imageMapping)
+}
+
+func resourceImageMappingDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, cloud_id_int, region_id_int, mapping_id_int, err := imageMappingIds(d)
+
+	if err != nil {
+		return err
+	}
+
+	err = client.DeleteImageMapping(tenant_id_int, cloud_id_int, region_id_int, mapping_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceImageMappingImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, cloud_id, region_id, mapping_id, err := parseRegionImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("cloud_id", cloud_id); err != nil {
+		return nil, errors.New("CANNOT SET CLOUD ID")
+	}
+	if err := d.Set("region_id", region_id); err != nil {
+		return nil, errors.New("CANNOT SET REGION ID")
+	}
+	if err := d.Set("mapping_id", mapping_id); err != nil {
+		return nil, errors.New("CANNOT SET MAPPING ID")
+	}
+
+	if err := resourceImageMappingRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT IMAGE MAPPING: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// imageMappingIds converts the IDs that locate an image mapping under its
+// cloud region into the integers the client library expects.
+func imageMappingIds(d *schema.ResourceData) (int, int, int, int, error) {
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return 0, 0, 0, 0, errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE MAPPING - TENANT ID INCORRECT")
+	}
+
+	cloud_id_int, err := strconv.Atoi(d.Get("cloud_id").(string))
+
+	if err != nil {
+		return 0, 0, 0, 0, errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE MAPPING - CLOUD ID INCORRECT")
+	}
+
+	region_id_int, err := strconv.Atoi(d.Get("region_id").(string))
+
+	if err != nil {
+		return 0, 0, 0, 0, errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE MAPPING - REGION ID INCORRECT")
+	}
+
+	mapping_id_int, err := strconv.Atoi(d.Get("mapping_id").(string))
+
+	if err != nil {
+		return 0, 0, 0, 0, errors.New("UNABLE TO RETRIEVE DETAILS FOR IMAGE MAPPING - MAPPING ID INCORRECT")
+	}
+
+	return tenant_id_int, cloud_id_int, region_id_int, mapping_id_int, nil
+}
+
+func setImageMappingResourceData(d *schema.ResourceData, u *cloudcenter.ImageMapping) error {
+
+	if err := d.Set("mapping_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("image_id", u.ImageId); err != nil {
+		return errors.New("CANNOT SET IMAGE ID")
+	}
+	if err := d.Set("cloud_id", u.CloudId); err != nil {
+		return errors.New("CANNOT SET CLOUD ID")
+	}
+	if err := d.Set("region_id", u.RegionId); err != nil {
+		return errors.New("CANNOT SET REGION ID")
+	}
+	if err := d.Set("cloud_image_id", u.CloudImageId); err != nil {
+		return errors.New("CANNOT SET CLOUD IMAGE ID")
+	}
+	if err := d.Set("instance_types", u.InstanceTypes); err != nil {
+		return errors.New("CANNOT SET INSTANCE TYPES")
+	}
+	if err := d.Set("tenant_id", u.TenantId); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterImageMapping_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_image_mapping", "mapping_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterImageMappingConfig("ami-0123456789", "t2.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_image_mapping.mapping", "mapping_id"),
+					resource.TestCheckResourceAttr("cloudcenter_image_mapping.mapping", "cloud_image_id", "ami-0123456789"),
+					resource.TestCheckResourceAttr("cloudcenter_image_mapping.mapping", "instance_types.0", "t2.small"),
+				),
+			},
+			{
+				Config: testAccCloudCenterImageMappingConfig("ami-0123456789", "t2.medium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_image_mapping.mapping", "mapping_id"),
+					resource.TestCheckResourceAttr("cloudcenter_image_mapping.mapping", "instance_types.0", "t2.medium"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_image_mapping.mapping",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterImageMapping_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_image_mapping", "mapping_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterImageMappingConfig("ami-0123456789", "t2.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_image_mapping.mapping", "mapping_id"),
+					testAccCheckDisappears("cloudcenter_image_mapping.mapping", "mapping_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterImageMappingConfig(cloudImageId string, instanceType string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_cloud" "cloud" {
+    cloud_name   = "terraform-cloud"
+    cloud_family = "Amazon"
+    tenant_id    = "1"
+}
+
+resource "cloudcenter_cloud_region" "region" {
+    cloud_id    = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_name = "us-east-1"
+    tenant_id   = "1"
+}
+
+resource "cloudcenter_image" "image" {
+    image_name  = "terraform-image"
+    tenant_id   = "1"
+    os_name     = "Linux"
+    image_type  = "CENTOS"
+    num_of_nics = 1
+    enabled     = true
+}
+
+resource "cloudcenter_image_mapping" "mapping" {
+    image_id       = "${cloudcenter_image.image.image_id}"
+    cloud_id       = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_id      = "${cloudcenter_cloud_region.region.region_id}"
+    cloud_image_id = "%s"
+    instance_types = ["%s"]
+    tenant_id      = "1"
+}
+`, cloudImageId, instanceType)
+}
@@ -0,0 +1,179 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceImageV0 is the schema of cloudcenter_image at schema version 0. It
+// must not be changed, as it is used to decode state written by older
+// versions of the provider.
+func resourceImageV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"internal_image_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"visibility": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"image_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"os_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			/*"tags": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},*/
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"system_image": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"num_of_nics": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"attach_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceImageStateUpgradeV0 rewrites the ID to <tenant_id>:<image_id>.
+// State created before version 1 used <tenant_id>:<name> as the ID.
+func resourceImageStateUpgradeV0(rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+
+	image_id, _ := rawState["image_id"].(string)
+
+	if image_id == "" {
+		return rawState, nil
+	}
+
+	rawState["id"] = rawStateString(rawState["tenant_id"]) + ":" + image_id
+
+	return rawState, nil
+}
+
+// resourceImageV1 is the schema of cloudcenter_image at schema version 1,
+// which held tenant_id as a number. It must not be changed.
+func resourceImageV1() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"internal_image_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"visibility": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"image_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"os_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"system_image": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"num_of_nics": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"attach_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceImageStateUpgradeV1 converts tenant_id to a string, as
+// used by every other resource.
+func resourceImageStateUpgradeV1(rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+
+	rawState["tenant_id"] = rawStateString(rawState["tenant_id"])
+
+	return rawState, nil
+}
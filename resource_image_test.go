@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterImage_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_image", "image_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterImageConfig("terraform-image", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_image.image", "image_id"),
+					resource.TestCheckResourceAttr("cloudcenter_image.image", "image_name", "terraform-image"),
+					resource.TestCheckResourceAttr("cloudcenter_image.image", "description", "First"),
+					resource.TestCheckResourceAttr("cloudcenter_image.image", "tags.#", "2"),
+					resource.TestCheckResourceAttr("cloudcenter_image.image", "tags.0", "production"),
+				),
+			},
+			{
+				Config: testAccCloudCenterImageConfig("terraform-image", "Second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_image.image", "image_id"),
+					resource.TestCheckResourceAttr("cloudcenter_image.image", "description", "Second"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_image.image",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterImage_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_image", "image_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterImageConfig("terraform-image", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_image.image", "image_id"),
+					testAccCheckDisappears("cloudcenter_image.image", "image_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterImage_apiErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_image", "image_id"),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("POST", "images", 409)
+				},
+				Config:      testAccCloudCenterImageConfig("terraform-image", "First"),
+				ExpectError: regexp.MustCompile("409"),
+			},
+			{
+				Config: testAccCloudCenterImageConfig("terraform-image", "First"),
+				Check:  testAccCheckExists("cloudcenter_image.image", "image_id"),
+			},
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("GET", "images", 500)
+				},
+				Config:      testAccCloudCenterImageConfig("terraform-image", "First"),
+				ExpectError: regexp.MustCompile("UNABLE TO RETRIEVE DETAILS FOR IMAGE"),
+			},
+		},
+	})
+}
+
+func testAccCloudCenterImageConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_image" "image" {
+    image_name  = "%s"
+    description = "%s"
+    tenant_id   = "1"
+    os_name     = "Linux"
+    image_type  = "CENTOS"
+    num_of_nics = 1
+    enabled     = true
+    tags        = ["production", "linux"]
+}
+`, name, description)
+}
+
+func TestResourceImageStateUpgradeV0(t *testing.T) {
+
+	rawState := map[string]interface{}{
+		"id":         "1000000:terraform-image",
+		"image_id":   "5",
+		"image_name": "terraform-image",
+		"tenant_id":  float64(1000000),
+	}
+
+	upgraded, err := resourceImageStateUpgradeV0(rawState, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if upgraded["id"] != "1000000:5" {
+		t.Errorf("expected ID 1000000:5, got %s", upgraded["id"])
+	}
+}
+
+func TestResourceImageStateUpgradeV1(t *testing.T) {
+
+	rawState := map[string]interface{}{
+		"id":        "1000000:5",
+		"image_id":  "5",
+		"tenant_id": float64(1000000),
+	}
+
+	upgraded, err := resourceImageStateUpgradeV1(rawState, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if upgraded["tenant_id"] != "1000000" {
+		t.Errorf("expected tenant_id 1000000, got %#v", upgraded["tenant_id"])
+	}
+}
@@ -0,0 +1,313 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"log"
+	"strconv"
+)
+
+func resourceInstanceType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceTypeCreate,
+		Read:   resourceInstanceTypeRead,
+		Update: resourceInstanceTypeUpdate,
+		Delete: resourceInstanceTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceInstanceTypeImport,
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"instance_type_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_type_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cpu": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"memory": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"storage": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"nics": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"price": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"architecture": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "X64",
+				ValidateFunc: validation.StringInSlice([]string{"X32", "X64", "X32_X64"}, false),
+			},
+			"cloud_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceInstanceTypeCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	instanceType, err := client.AddInstanceType(expandInstanceType(d))
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + d.Get("cloud_id").(string) + ":" + d.Get("region_id").(string) + ":" + instanceType.Id)
+
+	return setInstanceTypeResourceData(d, instanceType)
+}
+
+func resourceInstanceTypeRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	tenant_id_int, cloud_id_int, region_id_int, instance_type_id_int, err := instanceTypeIds(d)
+
+	if err != nil {
+		return err
+	}
+
+	instanceType, err := client.GetInstanceType(tenant_id_int, cloud_id_int, region_id_int, instance_type_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter instance type %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR INSTANCE TYPE: " + err.Error())
+	}
+
+	return setInstanceTypeResourceData(d, instanceType)
+}
+
+func resourceInstanceTypeUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newInstanceType := expandInstanceType(d)
+	newInstanceType.Id = d.Get("instance_type_id").(string)
+
+	instanceType, err := client.UpdateInstanceType(newInstanceType)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setInstanceTypeResourceData(d, instanceType)
+}
+
+func resourceInstanceTypeDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, cloud_id_int, region_id_int, instance_type_id_int, err := instanceTypeIds(d)
+
+	if err != nil {
+		return err
+	}
+
+	err = client.DeleteInstanceType(tenant_id_int, cloud_id_int, region_id_int, instance_type_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceInstanceTypeImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, cloud_id, region_id, instance_type_id, err := parseRegionImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("cloud_id", cloud_id); err != nil {
+		return nil, errors.New("CANNOT SET CLOUD ID")
+	}
+	if err := d.Set("region_id", region_id); err != nil {
+		return nil, errors.New("CANNOT SET REGION ID")
+	}
+	if err := d.Set("instance_type_id", instance_type_id); err != nil {
+		return nil, errors.New("CANNOT SET INSTANCE TYPE ID")
+	}
+
+	if err := resourceInstanceTypeRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT INSTANCE TYPE: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// instanceTypeIds converts the IDs that locate an instance type under its
+// cloud region into the integers the client library expects.
+func instanceTypeIds(d *schema.ResourceData) (int, int, int, int, error) {
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return 0, 0, 0, 0, errors.New("UNABLE TO RETRIEVE DETAILS FOR INSTANCE TYPE - TENANT ID INCORRECT")
+	}
+
+	cloud_id_int, err := strconv.Atoi(d.Get("cloud_id").(string))
+
+	if err != nil {
+		return 0, 0, 0, 0, errors.New("UNABLE TO RETRIEVE DETAILS FOR INSTANCE TYPE - CLOUD ID INCORRECT")
+	}
+
+	region_id_int, err := strconv.Atoi(d.Get("region_id").(string))
+
+	if err != nil {
+		return 0, 0, 0, 0, errors.New("UNABLE TO RETRIEVE DETAILS FOR INSTANCE TYPE - REGION ID INCORRECT")
+	}
+
+	instance_type_id_int, err := strconv.Atoi(d.Get("instance_type_id").(string))
+
+	if err != nil {
+		return 0, 0, 0, 0, errors.New("UNABLE TO RETRIEVE DETAILS FOR INSTANCE TYPE - INSTANCE TYPE ID INCORRECT")
+	}
+
+	return tenant_id_int, cloud_id_int, region_id_int, instance_type_id_int, nil
+}
+
+func expandInstanceType(d *schema.ResourceData) *cloudcenter.InstanceType {
+
+	return &cloudcenter.InstanceType{
+
+		TenantId:              d.Get("tenant_id").(string),
+		CloudId:               d.Get("cloud_id").(string),
+		RegionId:              d.Get("region_id").(string),
+		Name:                  d.Get("instance_type_name").(string),
+		Description:           d.Get("description").(string),
+		NumCpus:               d.Get("cpu").(int),
+		MemorySize:            d.Get("memory").(int),
+		LocalStorageSize:      d.Get("storage").(int),
+		NumNics:               d.Get("nics").(int),
+		CostPerHour:           d.Get("price").(float64),
+		SupportedArchitecture: d.Get("architecture").(string),
+	}
+}
+
+func setInstanceTypeResourceData(d *schema.ResourceData, u *cloudcenter.InstanceType) error {
+
+	if err := d.Set("instance_type_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("instance_type_name", u.Name); err != nil {
+		return errors.New("CANNOT SET INSTANCE TYPE NAME")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("description", u.Description); err != nil {
+		return errors.New("CANNOT SET DESCRIPTION")
+	}
+	if err := d.Set("cpu", u.NumCpus); err != nil {
+		return errors.New("CANNOT SET CPU")
+	}
+	if err := d.Set("memory", u.MemorySize); err != nil {
+		return errors.New("CANNOT SET MEMORY")
+	}
+	if err := d.Set("storage", u.LocalStorageSize); err != nil {
+		return errors.New("CANNOT SET STORAGE")
+	}
+	if err := d.Set("nics", u.NumNics); err != nil {
+		return errors.New("CANNOT SET NICS")
+	}
+	if err := d.Set("price", u.CostPerHour); err != nil {
+		return errors.New("CANNOT SET PRICE")
+	}
+	if err := d.Set("architecture", u.SupportedArchitecture); err != nil {
+		return errors.New("CANNOT SET ARCHITECTURE")
+	}
+	if err := d.Set("cloud_id", u.CloudId); err != nil {
+		return errors.New("CANNOT SET CLOUD ID")
+	}
+	if err := d.Set("region_id", u.RegionId); err != nil {
+		return errors.New("CANNOT SET REGION ID")
+	}
+	if err := d.Set("tenant_id", u.TenantId); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
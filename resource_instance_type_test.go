@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterInstanceType_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_instance_type", "instance_type_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterInstanceTypeConfig("terraform-instance-type", "0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_instance_type.instance_type", "instance_type_id"),
+					resource.TestCheckResourceAttr("cloudcenter_instance_type.instance_type", "instance_type_name", "terraform-instance-type"),
+					resource.TestCheckResourceAttr("cloudcenter_instance_type.instance_type", "price", "0.1"),
+				),
+			},
+			{
+				Config: testAccCloudCenterInstanceTypeConfig("terraform-instance-type", "0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_instance_type.instance_type", "instance_type_id"),
+					resource.TestCheckResourceAttr("cloudcenter_instance_type.instance_type", "price", "0.2"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_instance_type.instance_type",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterInstanceType_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_instance_type", "instance_type_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterInstanceTypeConfig("terraform-instance-type", "0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_instance_type.instance_type", "instance_type_id"),
+					testAccCheckDisappears("cloudcenter_instance_type.instance_type", "instance_type_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterInstanceTypeConfig(name string, price string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_cloud" "cloud" {
+    cloud_name   = "terraform-cloud"
+    cloud_family = "Amazon"
+    tenant_id    = "1"
+}
+
+resource "cloudcenter_cloud_region" "region" {
+    cloud_id    = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_name = "us-east-1"
+    tenant_id   = "1"
+}
+
+resource "cloudcenter_instance_type" "instance_type" {
+    instance_type_name = "%s"
+    cpu                = 2
+    memory             = 4096
+    storage            = 20
+    nics               = 1
+    price              = %s
+    cloud_id           = "${cloudcenter_cloud.cloud.cloud_id}"
+    region_id          = "${cloudcenter_cloud_region.region.region_id}"
+    tenant_id          = "1"
+}
+`, name, price)
+}
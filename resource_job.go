@@ -0,0 +1,355 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"strconv"
+	"time"
+)
+
+// Job statuses reported by CloudCenter while a deployment is in progress.
+var jobPendingStatuses = []string{
+	"JobSubmitted",
+	"JobStarting",
+	"JobInProgress",
+	"JobResuming",
+}
+
+// jobTerminatedStatus is reported by CloudCenter once a deployment has been
+// terminated, whether by Terraform or in the UI.
+const jobTerminatedStatus = "JobStopped"
+
+func resourceJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceJobCreate,
+		Read:   resourceJobRead,
+		Delete: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceJobImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"job_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"app_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"app_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"deployment_environment_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameters": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceJobCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	var appParams []cloudcenter.AppParam
+
+	for name, value := range d.Get("parameters").(map[string]interface{}) {
+
+		newAppParam := cloudcenter.AppParam{
+			Name:  name,
+			Value: value.(string),
+		}
+
+		appParams = append(appParams, newAppParam)
+
+	}
+
+	newJob := cloudcenter.Job{
+
+		Name:          d.Get("job_name").(string),
+		AppId:         d.Get("app_id").(string),
+		AppVersion:    d.Get("app_version").(string),
+		EnvironmentId: d.Get("deployment_environment_id").(string),
+		Parameters: cloudcenter.Parameters{
+			AppParams: appParams,
+		},
+		Tags: expandStringList(d.Get("tags").([]interface{})),
+	}
+
+	job, err := client.AddJob(&newJob)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + job.Id)
+
+	if err := d.Set("job_id", job.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+
+	job_id_int, err := strconv.Atoi(job.Id)
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR JOB - JOB ID INCORRECT")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    jobPendingStatuses,
+		Target:     []string{"JobRunning"},
+		Refresh:    jobStatusRefreshFunc(client, job_id_int),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errors.New("JOB " + job.Id + " DID NOT REACH RUNNING STATE: " + err.Error())
+	}
+
+	return resourceJobRead(d, m)
+}
+
+func resourceJobRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	job_id_int, err := strconv.Atoi(d.Get("job_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR JOB - JOB ID INCORRECT")
+	}
+
+	job, err := client.GetJob(job_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter job %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR JOB: " + err.Error())
+	}
+
+	if job.Status == jobTerminatedStatus {
+		log.Printf("[WARN] CloudCenter job %s was terminated, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return setJobResourceData(d, job)
+}
+
+func resourceJobDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	job_id_int, err := strconv.Atoi(d.Get("job_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR JOB - JOB ID INCORRECT")
+	}
+
+	err = client.DeleteJob(job_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return errors.New(err.Error())
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"JobRunning", "JobStopping", "JobTerminating", "JobSuspended"},
+		Target:     []string{jobTerminatedStatus},
+		Refresh:    jobTerminatedRefreshFunc(client, job_id_int),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errors.New("JOB " + d.Get("job_id").(string) + " WAS NOT TERMINATED: " + err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceJobImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, job_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("job_id", job_id); err != nil {
+		return nil, errors.New("CANNOT SET JOB ID")
+	}
+
+	if err := resourceJobRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT JOB: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// jobStatusRefreshFunc reports the status of a job, returning nil once the
+// job no longer exists.
+func jobStatusRefreshFunc(client *cloudcenter.Client, job_id_int int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+
+		job, err := client.GetJob(job_id_int)
+
+		if err != nil {
+			if isNotFound(err) {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		return job, job.Status, nil
+	}
+}
+
+// jobTerminatedRefreshFunc is jobStatusRefreshFunc for a job being
+// terminated. A terminated job may be purged from CloudCenter straight away,
+// so a job that no longer exists is reported as terminated.
+func jobTerminatedRefreshFunc(client *cloudcenter.Client, job_id_int int) resource.StateRefreshFunc {
+
+	refresh := jobStatusRefreshFunc(client, job_id_int)
+
+	return func() (interface{}, string, error) {
+
+		job, status, err := refresh()
+
+		if err == nil && job == nil {
+			return struct{}{}, jobTerminatedStatus, nil
+		}
+
+		return job, status, err
+	}
+}
+
+func setJobResourceData(d *schema.ResourceData, u *cloudcenter.Job) error {
+
+	if err := d.Set("job_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("job_name", u.Name); err != nil {
+		return errors.New("CANNOT SET JOB NAME")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("app_id", u.AppId); err != nil {
+		return errors.New("CANNOT SET APP ID")
+	}
+	if err := d.Set("app_version", u.AppVersion); err != nil {
+		return errors.New("CANNOT SET APP VERSION")
+	}
+	if err := d.Set("deployment_environment_id", u.EnvironmentId); err != nil {
+		return errors.New("CANNOT SET DEPLOYMENT ENVIRONMENT ID")
+	}
+	if err := d.Set("parameters", flattenAppParams(u.Parameters.AppParams)); err != nil {
+		return errors.New("CANNOT SET PARAMETERS")
+	}
+	if err := d.Set("tags", u.Tags); err != nil {
+		return errors.New("CANNOT SET TAGS")
+	}
+	if err := d.Set("status", u.Status); err != nil {
+		return errors.New("CANNOT SET STATUS")
+	}
+
+	return nil
+}
+
+func flattenAppParams(appParams []cloudcenter.AppParam) map[string]interface{} {
+
+	result := make(map[string]interface{}, len(appParams))
+
+	for _, appParam := range appParams {
+		result[appParam.Name] = appParam.Value
+	}
+
+	return result
+}
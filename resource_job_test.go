@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterJob_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_job", "job_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterJobConfig("terraform-job"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_job.job", "job_id"),
+					resource.TestCheckResourceAttr("cloudcenter_job.job", "job_name", "terraform-job"),
+					resource.TestCheckResourceAttr("cloudcenter_job.job", "status", "JobRunning"),
+					resource.TestCheckResourceAttr("cloudcenter_job.job", "parameters.instanceType", "t2.small"),
+					resource.TestCheckResourceAttr("cloudcenter_job.job", "tags.0", "terraform"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_job.job",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterJob_terminatedOutsideTerraform(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_job", "job_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterJobConfig("terraform-job-terminated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_job.job", "job_id"),
+					testAccCheckJobTerminated("cloudcenter_job.job"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterJob_failed(t *testing.T) {
+	testAccCCM.setDefault("jobs", "status", "JobError")
+	defer testAccCCM.setDefault("jobs", "status", "JobRunning")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_job", "job_id"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudCenterJobConfig("terraform-job-failed"),
+				ExpectError: regexp.MustCompile("DID NOT REACH RUNNING STATE"),
+			},
+		},
+	})
+}
+
+// testAccCheckJobTerminated marks the job as terminated in the fake
+// CloudCenter Manager, as terminating it in the UI would.
+func testAccCheckJobTerminated(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		testAccCCM.update(rs.Primary.Attributes["job_id"], "status", "JobStopped")
+
+		return nil
+	}
+}
+
+func testAccCloudCenterJobConfig(name string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_job" "job" {
+    job_name                  = "%s"
+    app_id                    = "10"
+    app_version               = "1.0"
+    deployment_environment_id = "20"
+    tenant_id                 = "1"
+    tags                      = ["terraform"]
+
+    parameters = {
+        instanceType = "t2.small"
+    }
+}
+`, name)
+}
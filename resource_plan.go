@@ -23,6 +23,7 @@ import (
 	"errors"
 	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
 	"github.com/hashicorp/terraform/helper/schema"
+	"log"
 	"strconv"
 )
 
@@ -33,6 +34,21 @@ func resourcePlan() *schema.Resource {
 		Update: resourcePlanUpdate,
 		Delete: resourcePlanDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourcePlanImport,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourcePlanV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourcePlanStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
 		Schema: map[string]*schema.Schema{
 			"plan_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -53,7 +69,13 @@ func resourcePlan() *schema.Resource {
 			},
 			"tenant_id": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"type": &schema.Schema{
 				Type:     schema.TypeString,
@@ -132,12 +154,12 @@ func resourcePlan() *schema.Resource {
 }
 
 func resourcePlanCreate(d *schema.ResourceData, m interface{}) error {
-	plan_name := d.Get("plan_name").(string)
-	tenant_id := d.Get("tenant_id").(string)
 
-	d.SetId(tenant_id + ":" + plan_name)
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	newPlan := cloudcenter.Plan{
 
@@ -157,11 +179,13 @@ func resourcePlanCreate(d *schema.ResourceData, m interface{}) error {
 		return errors.New(err.Error())
 	}
 
+	d.SetId(d.Get("tenant_id").(string) + ":" + plan.Id)
+
 	return setPlanResourceData(d, plan)
 }
 
 func resourcePlanRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
 
@@ -178,7 +202,12 @@ func resourcePlanRead(d *schema.ResourceData, m interface{}) error {
 	plan, err := client.GetPlan(tenant_id_int, plan_id_int)
 
 	if err != nil {
-		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PLAN")
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter plan %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PLAN: " + err.Error())
 	}
 
 	return setPlanResourceData(d, plan)
@@ -186,7 +215,7 @@ func resourcePlanRead(d *schema.ResourceData, m interface{}) error {
 
 func resourcePlanUpdate(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	newPlan := cloudcenter.Plan{
 
@@ -212,7 +241,7 @@ func resourcePlanUpdate(d *schema.ResourceData, m interface{}) error {
 
 func resourcePlanDelete(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
 
@@ -228,7 +257,7 @@ func resourcePlanDelete(d *schema.ResourceData, m interface{}) error {
 
 	err = client.DeletePlan(tenant_id_int, plan_id_int)
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return errors.New(err.Error())
 	}
 
@@ -236,6 +265,32 @@ func resourcePlanDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+func resourcePlanImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, plan_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("plan_id", plan_id); err != nil {
+		return nil, errors.New("CANNOT SET PLAN ID")
+	}
+
+	if err := resourcePlanRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT PLAN: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setPlanResourceData(d *schema.ResourceData, u *cloudcenter.Plan) error {
 
 	if err := d.Set("plan_id", u.Id); err != nil {
@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourcePlanV0 is the schema of cloudcenter_plan at schema version 0. It
+// must not be changed, as it is used to decode state written by older
+// versions of the provider.
+func resourcePlanV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"plan_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"plan_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"monthly_limit": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"node_hour_increment": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"included_bundle_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"price": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"one_time_fee": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"annual_fee": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"storage_rate": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"hourly_rate": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"overage_rate": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"overage_limit": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"restricted_to_app_store_only": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"bill_to_vendor": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"enable_rollover": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"disabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"show_only_to_admin": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"number_of_users": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"number_of_projects": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourcePlanStateUpgradeV0 rewrites the ID to <tenant_id>:<plan_id>.
+// State created before version 1 used <tenant_id>:<name> as the ID.
+func resourcePlanStateUpgradeV0(rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+
+	plan_id, _ := rawState["plan_id"].(string)
+
+	if plan_id == "" {
+		return rawState, nil
+	}
+
+	rawState["id"] = rawStateString(rawState["tenant_id"]) + ":" + plan_id
+
+	return rawState, nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterPlan_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_plan", "plan_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterPlanConfig("terraform-plan", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_plan.plan", "plan_id"),
+					resource.TestCheckResourceAttr("cloudcenter_plan.plan", "plan_name", "terraform-plan"),
+					resource.TestCheckResourceAttr("cloudcenter_plan.plan", "description", "First"),
+				),
+			},
+			{
+				Config: testAccCloudCenterPlanConfig("terraform-plan", "Second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_plan.plan", "plan_id"),
+					resource.TestCheckResourceAttr("cloudcenter_plan.plan", "description", "Second"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_plan.plan",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterPlan_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_plan", "plan_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterPlanConfig("terraform-plan", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_plan.plan", "plan_id"),
+					testAccCheckDisappears("cloudcenter_plan.plan", "plan_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterPlan_apiErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_plan", "plan_id"),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("POST", "plans", 409)
+				},
+				Config:      testAccCloudCenterPlanConfig("terraform-plan", "First"),
+				ExpectError: regexp.MustCompile("409"),
+			},
+			{
+				Config: testAccCloudCenterPlanConfig("terraform-plan", "First"),
+				Check:  testAccCheckExists("cloudcenter_plan.plan", "plan_id"),
+			},
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("GET", "plans", 500)
+				},
+				Config:      testAccCloudCenterPlanConfig("terraform-plan", "First"),
+				ExpectError: regexp.MustCompile("UNABLE TO RETRIEVE DETAILS FOR PLAN"),
+			},
+		},
+	})
+}
+
+func testAccCloudCenterPlanConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_plan" "plan" {
+    plan_name   = "%s"
+    description = "%s"
+    tenant_id   = "1"
+    type        = "UNLIMITED_PLAN"
+    price       = 10
+}
+`, name, description)
+}
+
+func TestResourcePlanStateUpgradeV0(t *testing.T) {
+
+	cases := []struct {
+		name     string
+		rawState map[string]interface{}
+		id       string
+	}{
+		{
+			name: "name based ID",
+			rawState: map[string]interface{}{
+				"id":        "1:terraform-plan",
+				"plan_id":   "5",
+				"plan_name": "terraform-plan",
+				"tenant_id": "1",
+			},
+			id: "1:5",
+		},
+		{
+			name: "missing plan_id",
+			rawState: map[string]interface{}{
+				"id":        "1:terraform-plan",
+				"plan_name": "terraform-plan",
+				"tenant_id": "1",
+			},
+			id: "1:terraform-plan",
+		},
+	}
+
+	for _, c := range cases {
+
+		upgraded, err := resourcePlanStateUpgradeV0(c.rawState, nil)
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+
+		if upgraded["id"] != c.id {
+			t.Errorf("%s: expected ID %s, got %s", c.name, c.id, upgraded["id"])
+		}
+	}
+}
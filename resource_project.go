@@ -0,0 +1,273 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"strconv"
+)
+
+func resourceProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProjectCreate,
+		Read:   resourceProjectRead,
+		Update: resourceProjectUpdate,
+		Delete: resourceProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceProjectImport,
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"owner_user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"budget": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	newProject := cloudcenter.Project{
+
+		TenantId:    d.Get("tenant_id").(string),
+		Name:        d.Get("project_name").(string),
+		Description: d.Get("description").(string),
+		OwnerUserId: d.Get("owner_user_id").(string),
+		Budget:      d.Get("budget").(float64),
+		Users:       expandUsers(d.Get("users").([]interface{})),
+		Groups:      expandGroups(d.Get("groups").([]interface{})),
+	}
+
+	project, err := client.AddProject(&newProject)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + project.Id)
+
+	return setProjectResourceData(d, project)
+}
+
+func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PROJECT - TENANT ID INCORRECT")
+	}
+
+	project_id_int, err := strconv.Atoi(d.Get("project_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PROJECT - PROJECT ID INCORRECT")
+	}
+
+	project, err := client.GetProject(tenant_id_int, project_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter project %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PROJECT: " + err.Error())
+	}
+
+	return setProjectResourceData(d, project)
+}
+
+func resourceProjectUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newProject := cloudcenter.Project{
+
+		Id:          d.Get("project_id").(string),
+		TenantId:    d.Get("tenant_id").(string),
+		Name:        d.Get("project_name").(string),
+		Description: d.Get("description").(string),
+		OwnerUserId: d.Get("owner_user_id").(string),
+		Budget:      d.Get("budget").(float64),
+		Users:       expandUsers(d.Get("users").([]interface{})),
+		Groups:      expandGroups(d.Get("groups").([]interface{})),
+	}
+
+	project, err := client.UpdateProject(&newProject)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setProjectResourceData(d, project)
+}
+
+func resourceProjectDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PROJECT - TENANT ID INCORRECT")
+	}
+
+	project_id_int, err := strconv.Atoi(d.Get("project_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PROJECT - PROJECT ID INCORRECT")
+	}
+
+	err = client.DeleteProject(tenant_id_int, project_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceProjectImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, project_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("project_id", project_id); err != nil {
+		return nil, errors.New("CANNOT SET PROJECT ID")
+	}
+
+	if err := resourceProjectRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT PROJECT: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setProjectResourceData(d *schema.ResourceData, u *cloudcenter.Project) error {
+
+	if err := d.Set("project_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("project_name", u.Name); err != nil {
+		return errors.New("CANNOT SET PROJECT NAME")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("description", u.Description); err != nil {
+		return errors.New("CANNOT SET DESCRIPTION")
+	}
+	if err := d.Set("owner_user_id", u.OwnerUserId); err != nil {
+		return errors.New("CANNOT SET OWNER USER ID")
+	}
+	if err := d.Set("budget", u.Budget); err != nil {
+		return errors.New("CANNOT SET BUDGET")
+	}
+	if err := d.Set("users", flattenUsers(u.Users)); err != nil {
+		return errors.New("CANNOT SET USERS")
+	}
+	if err := d.Set("groups", flattenGroups(u.Groups)); err != nil {
+		return errors.New("CANNOT SET GROUPS")
+	}
+	if err := d.Set("tenant_id", u.TenantId); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
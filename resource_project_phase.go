@@ -0,0 +1,274 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"strconv"
+)
+
+func resourceProjectPhase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProjectPhaseCreate,
+		Read:   resourceProjectPhaseRead,
+		Update: resourceProjectPhaseUpdate,
+		Delete: resourceProjectPhaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceProjectPhaseImport,
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"phase_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phase_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"budget": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"app_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"deployment_environment_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceProjectPhaseCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	newPhase := cloudcenter.Phase{
+
+		TenantId:       d.Get("tenant_id").(string),
+		ProjectId:      d.Get("project_id").(string),
+		Name:           d.Get("phase_name").(string),
+		Description:    d.Get("description").(string),
+		Budget:         d.Get("budget").(float64),
+		AppIds:         expandStringList(d.Get("app_ids").([]interface{})),
+		EnvironmentIds: expandStringList(d.Get("deployment_environment_ids").([]interface{})),
+	}
+
+	phase, err := client.AddPhase(&newPhase)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + d.Get("project_id").(string) + ":" + phase.Id)
+
+	return setProjectPhaseResourceData(d, phase)
+}
+
+func resourceProjectPhaseRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PROJECT PHASE - TENANT ID INCORRECT")
+	}
+
+	project_id_int, err := strconv.Atoi(d.Get("project_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PROJECT PHASE - PROJECT ID INCORRECT")
+	}
+
+	phase_id_int, err := strconv.Atoi(d.Get("phase_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PROJECT PHASE - PHASE ID INCORRECT")
+	}
+
+	phase, err := client.GetPhase(tenant_id_int, project_id_int, phase_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter project phase %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PROJECT PHASE: " + err.Error())
+	}
+
+	return setProjectPhaseResourceData(d, phase)
+}
+
+func resourceProjectPhaseUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newPhase := cloudcenter.Phase{
+
+		Id:             d.Get("phase_id").(string),
+		TenantId:       d.Get("tenant_id").(string),
+		ProjectId:      d.Get("project_id").(string),
+		Name:           d.Get("phase_name").(string),
+		Description:    d.Get("description").(string),
+		Budget:         d.Get("budget").(float64),
+		AppIds:         expandStringList(d.Get("app_ids").([]interface{})),
+		EnvironmentIds: expandStringList(d.Get("deployment_environment_ids").([]interface{})),
+	}
+
+	phase, err := client.UpdatePhase(&newPhase)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setProjectPhaseResourceData(d, phase)
+}
+
+func resourceProjectPhaseDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PROJECT PHASE - TENANT ID INCORRECT")
+	}
+
+	project_id_int, err := strconv.Atoi(d.Get("project_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PROJECT PHASE - PROJECT ID INCORRECT")
+	}
+
+	phase_id_int, err := strconv.Atoi(d.Get("phase_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR PROJECT PHASE - PHASE ID INCORRECT")
+	}
+
+	err = client.DeletePhase(tenant_id_int, project_id_int, phase_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceProjectPhaseImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, project_id, phase_id, err := parseNestedImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("project_id", project_id); err != nil {
+		return nil, errors.New("CANNOT SET PROJECT ID")
+	}
+	if err := d.Set("phase_id", phase_id); err != nil {
+		return nil, errors.New("CANNOT SET PHASE ID")
+	}
+
+	if err := resourceProjectPhaseRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT PROJECT PHASE: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setProjectPhaseResourceData(d *schema.ResourceData, u *cloudcenter.Phase) error {
+
+	if err := d.Set("phase_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("phase_name", u.Name); err != nil {
+		return errors.New("CANNOT SET PHASE NAME")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("project_id", u.ProjectId); err != nil {
+		return errors.New("CANNOT SET PROJECT ID")
+	}
+	if err := d.Set("description", u.Description); err != nil {
+		return errors.New("CANNOT SET DESCRIPTION")
+	}
+	if err := d.Set("budget", u.Budget); err != nil {
+		return errors.New("CANNOT SET BUDGET")
+	}
+	if err := d.Set("app_ids", u.AppIds); err != nil {
+		return errors.New("CANNOT SET APP IDS")
+	}
+	if err := d.Set("deployment_environment_ids", u.EnvironmentIds); err != nil {
+		return errors.New("CANNOT SET DEPLOYMENT ENVIRONMENT IDS")
+	}
+	if err := d.Set("tenant_id", u.TenantId); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterProjectPhase_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_project_phase", "phase_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterProjectPhaseConfig("development", "100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_project_phase.phase", "phase_id"),
+					resource.TestCheckResourceAttr("cloudcenter_project_phase.phase", "phase_name", "development"),
+					resource.TestCheckResourceAttr("cloudcenter_project_phase.phase", "budget", "100"),
+				),
+			},
+			{
+				Config: testAccCloudCenterProjectPhaseConfig("development", "250"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_project_phase.phase", "phase_id"),
+					resource.TestCheckResourceAttr("cloudcenter_project_phase.phase", "budget", "250"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_project_phase.phase",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterProjectPhase_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_project_phase", "phase_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterProjectPhaseConfig("development", "100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_project_phase.phase", "phase_id"),
+					testAccCheckDisappears("cloudcenter_project_phase.phase", "phase_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterProjectPhaseConfig(name string, budget string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_project" "project" {
+    project_name = "terraform-project"
+    tenant_id    = "1"
+}
+
+resource "cloudcenter_project_phase" "phase" {
+    project_id                 = "${cloudcenter_project.project.project_id}"
+    phase_name                 = "%s"
+    budget                     = %s
+    app_ids                    = ["10"]
+    deployment_environment_ids = ["20"]
+    tenant_id                  = "1"
+}
+`, name, budget)
+}
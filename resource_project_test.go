@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterProject_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_project", "project_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterProjectConfig("terraform-project", "1000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_project.project", "project_id"),
+					resource.TestCheckResourceAttr("cloudcenter_project.project", "project_name", "terraform-project"),
+					resource.TestCheckResourceAttr("cloudcenter_project.project", "budget", "1000"),
+				),
+			},
+			{
+				Config: testAccCloudCenterProjectConfig("terraform-project", "2500"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_project.project", "project_id"),
+					resource.TestCheckResourceAttr("cloudcenter_project.project", "budget", "2500"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_project.project",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterProject_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_project", "project_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterProjectConfig("terraform-project", "1000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_project.project", "project_id"),
+					testAccCheckDisappears("cloudcenter_project.project", "project_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterProjectConfig(name string, budget string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_project" "project" {
+    project_name  = "%s"
+    description   = "Onboarded through Terraform"
+    owner_user_id = "2"
+    budget        = %s
+    tenant_id     = "1"
+
+    users {
+        user_id = "2"
+    }
+
+    groups {
+        group_id = "3"
+    }
+}
+`, name, budget)
+}
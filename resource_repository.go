@@ -0,0 +1,291 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"log"
+	"strconv"
+)
+
+func resourceRepository() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRepositoryCreate,
+		Read:   resourceRepositoryRead,
+		Update: resourceRepositoryUpdate,
+		Delete: resourceRepositoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceRepositoryImport,
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"repository_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"repository_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"HTTP", "S3", "NEXUS"}, false),
+			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"username": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRepositoryCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	newRepository := cloudcenter.Repository{
+
+		TenantId:    d.Get("tenant_id").(string),
+		DisplayName: d.Get("display_name").(string),
+		Description: d.Get("description").(string),
+		Type:        d.Get("repository_type").(string),
+		Url:         d.Get("url").(string),
+		Username:    d.Get("username").(string),
+		Password:    d.Get("password").(string),
+		Users:       expandUsers(d.Get("users").([]interface{})),
+		Groups:      expandGroups(d.Get("groups").([]interface{})),
+	}
+
+	repository, err := client.AddRepository(&newRepository)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + repository.Id)
+
+	return setRepositoryResourceData(d, repository)
+}
+
+func resourceRepositoryRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR REPOSITORY - TENANT ID INCORRECT")
+	}
+
+	repository_id_int, err := strconv.Atoi(d.Get("repository_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR REPOSITORY - REPOSITORY ID INCORRECT")
+	}
+
+	repository, err := client.GetRepository(tenant_id_int, repository_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter repository %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR REPOSITORY: " + err.Error())
+	}
+
+	return setRepositoryResourceData(d, repository)
+}
+
+func resourceRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newRepository := cloudcenter.Repository{
+
+		Id:          d.Get("repository_id").(string),
+		TenantId:    d.Get("tenant_id").(string),
+		DisplayName: d.Get("display_name").(string),
+		Description: d.Get("description").(string),
+		Type:        d.Get("repository_type").(string),
+		Url:         d.Get("url").(string),
+		Username:    d.Get("username").(string),
+		Password:    d.Get("password").(string),
+		Users:       expandUsers(d.Get("users").([]interface{})),
+		Groups:      expandGroups(d.Get("groups").([]interface{})),
+	}
+
+	repository, err := client.UpdateRepository(&newRepository)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setRepositoryResourceData(d, repository)
+}
+
+func resourceRepositoryDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR REPOSITORY - TENANT ID INCORRECT")
+	}
+
+	repository_id_int, err := strconv.Atoi(d.Get("repository_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR REPOSITORY - REPOSITORY ID INCORRECT")
+	}
+
+	err = client.DeleteRepository(tenant_id_int, repository_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceRepositoryImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, repository_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("repository_id", repository_id); err != nil {
+		return nil, errors.New("CANNOT SET REPOSITORY ID")
+	}
+
+	if err := resourceRepositoryRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT REPOSITORY: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setRepositoryResourceData leaves username and password as configured, as
+// the CCM does not return the credentials of a repository.
+func setRepositoryResourceData(d *schema.ResourceData, u *cloudcenter.Repository) error {
+
+	if err := d.Set("repository_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("display_name", u.DisplayName); err != nil {
+		return errors.New("CANNOT SET DISPLAY NAME")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("description", u.Description); err != nil {
+		return errors.New("CANNOT SET DESCRIPTION")
+	}
+	if err := d.Set("repository_type", u.Type); err != nil {
+		return errors.New("CANNOT SET REPOSITORY TYPE")
+	}
+	if err := d.Set("url", u.Url); err != nil {
+		return errors.New("CANNOT SET URL")
+	}
+	if err := d.Set("users", flattenUsers(u.Users)); err != nil {
+		return errors.New("CANNOT SET USERS")
+	}
+	if err := d.Set("groups", flattenGroups(u.Groups)); err != nil {
+		return errors.New("CANNOT SET GROUPS")
+	}
+	if err := d.Set("tenant_id", u.TenantId); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterRepository_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_repository", "repository_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterRepositoryConfig("terraform-repository", "https://repo.example.com/first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_repository.repository", "repository_id"),
+					resource.TestCheckResourceAttr("cloudcenter_repository.repository", "display_name", "terraform-repository"),
+					resource.TestCheckResourceAttr("cloudcenter_repository.repository", "url", "https://repo.example.com/first"),
+				),
+			},
+			{
+				Config: testAccCloudCenterRepositoryConfig("terraform-repository", "https://repo.example.com/second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_repository.repository", "repository_id"),
+					resource.TestCheckResourceAttr("cloudcenter_repository.repository", "url", "https://repo.example.com/second"),
+				),
+			},
+			{
+				ResourceName:            "cloudcenter_repository.repository",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"username", "password"},
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterRepository_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_repository", "repository_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterRepositoryConfig("terraform-repository", "https://repo.example.com/first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_repository.repository", "repository_id"),
+					testAccCheckDisappears("cloudcenter_repository.repository", "repository_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterRepositoryConfig(name string, url string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_repository" "repository" {
+    display_name    = "%s"
+    repository_type = "HTTP"
+    url             = "%s"
+    username        = "deploy"
+    password        = "secret"
+    tenant_id       = "1"
+
+    users {
+        user_id = "2"
+    }
+
+    groups {
+        group_id = "3"
+    }
+}
+`, name, url)
+}
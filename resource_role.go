@@ -23,6 +23,7 @@ import (
 	"errors"
 	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
 	"github.com/hashicorp/terraform/helper/schema"
+	"log"
 	"strconv"
 )
 
@@ -33,6 +34,21 @@ func resourceRole() *schema.Resource {
 		Update: resourceRoleUpdate,
 		Delete: resourceRoleDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceRoleImport,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceRoleV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceRoleStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
 		Schema: map[string]*schema.Schema{
 			"role_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -111,7 +127,13 @@ func resourceRole() *schema.Resource {
 			},
 			"tenant_id": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 	}
@@ -119,12 +141,11 @@ func resourceRole() *schema.Resource {
 
 func resourceRoleCreate(d *schema.ResourceData, m interface{}) error {
 
-	role_name := d.Get("role_name").(string)
-	tenant_id := d.Get("tenant_id").(string)
-
-	d.SetId(tenant_id + ":" + role_name)
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	var objectPerms []cloudcenter.ObjectPerm
 
@@ -151,52 +172,15 @@ func resourceRoleCreate(d *schema.ResourceData, m interface{}) error {
 
 	}
 
-	var users []cloudcenter.User
-
-	allUsers := d.Get("users").([]interface{})
-
-	for _, user := range allUsers {
-
-		u, _ := user.(map[string]interface{})
-
-		newUser := cloudcenter.User{
-			Id: u["user_id"].(string),
-		}
-
-		users = append(users, newUser)
-
-	}
-
-	var groups []cloudcenter.Group
-
-	allGroups := d.Get("groups").([]interface{})
-
-	for _, group := range allGroups {
-
-		g, _ := group.(map[string]interface{})
-
-		newGroup := cloudcenter.Group{
-			Id: g["group_id"].(string),
-		}
-
-		groups = append(groups, newGroup)
-
-	}
-
-	perms := []string{}
-	for _, perm := range d.Get("perms").([]interface{}) {
-		perms = append(perms, perm.(string))
-	}
-
 	newRole := cloudcenter.Role{
 
 		TenantId:    d.Get("tenant_id").(string),
 		Name:        d.Get("role_name").(string),
 		Description: d.Get("description").(string),
 		ObjectPerms: objectPerms,
-		Users:       users,
-		Groups:      groups,
-		Perms:       perms,
+		Users:       expandUsers(d.Get("users").([]interface{})),
+		Groups:      expandGroups(d.Get("groups").([]interface{})),
+		Perms:       expandStringList(d.Get("perms").([]interface{})),
 	}
 
 	role, err := client.AddRole(&newRole)
@@ -205,11 +189,13 @@ func resourceRoleCreate(d *schema.ResourceData, m interface{}) error {
 		return errors.New(err.Error())
 	}
 
+	d.SetId(d.Get("tenant_id").(string) + ":" + role.Id)
+
 	return setRoleResourceData(d, role)
 }
 
 func resourceRoleRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
 
@@ -226,7 +212,12 @@ func resourceRoleRead(d *schema.ResourceData, m interface{}) error {
 	role, err := client.GetRole(tenant_id_int, role_id_int)
 
 	if err != nil {
-		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ROLE")
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter role %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR ROLE: " + err.Error())
 	}
 
 	return setRoleResourceData(d, role)
@@ -234,7 +225,7 @@ func resourceRoleRead(d *schema.ResourceData, m interface{}) error {
 
 func resourceRoleUpdate(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	var objectPerms []cloudcenter.ObjectPerm
 
@@ -261,43 +252,6 @@ func resourceRoleUpdate(d *schema.ResourceData, m interface{}) error {
 
 	}
 
-	var users []cloudcenter.User
-
-	allUsers := d.Get("users").([]interface{})
-
-	for _, user := range allUsers {
-
-		u, _ := user.(map[string]interface{})
-
-		newUser := cloudcenter.User{
-			Id: u["user_id"].(string),
-		}
-
-		users = append(users, newUser)
-
-	}
-
-	var groups []cloudcenter.Group
-
-	allGroups := d.Get("groups").([]interface{})
-
-	for _, group := range allGroups {
-
-		g, _ := group.(map[string]interface{})
-
-		newGroup := cloudcenter.Group{
-			Id: g["group_id"].(string),
-		}
-
-		groups = append(groups, newGroup)
-
-	}
-
-	perms := []string{}
-	for _, perm := range d.Get("perms").([]interface{}) {
-		perms = append(perms, perm.(string))
-	}
-
 	newRole := cloudcenter.Role{
 
 		Id:          d.Get("role_id").(string),
@@ -305,9 +259,9 @@ func resourceRoleUpdate(d *schema.ResourceData, m interface{}) error {
 		Name:        d.Get("role_name").(string),
 		Description: d.Get("description").(string),
 		ObjectPerms: objectPerms,
-		Users:       users,
-		Groups:      groups,
-		Perms:       perms,
+		Users:       expandUsers(d.Get("users").([]interface{})),
+		Groups:      expandGroups(d.Get("groups").([]interface{})),
+		Perms:       expandStringList(d.Get("perms").([]interface{})),
 	}
 
 	role, err := client.UpdateRole(&newRole)
@@ -321,7 +275,7 @@ func resourceRoleUpdate(d *schema.ResourceData, m interface{}) error {
 
 func resourceRoleDelete(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
 
@@ -337,7 +291,7 @@ func resourceRoleDelete(d *schema.ResourceData, m interface{}) error {
 
 	err = client.DeleteRole(tenant_id_int, role_id_int)
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return errors.New(err.Error())
 	}
 
@@ -345,6 +299,32 @@ func resourceRoleDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+func resourceRoleImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, role_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("role_id", role_id); err != nil {
+		return nil, errors.New("CANNOT SET ROLE ID")
+	}
+
+	if err := resourceRoleRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT ROLE: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setRoleResourceData(d *schema.ResourceData, u *cloudcenter.Role) error {
 
 	if err := d.Set("role_id", u.Id); err != nil {
@@ -362,13 +342,13 @@ func setRoleResourceData(d *schema.ResourceData, u *cloudcenter.Role) error {
 	if err := d.Set("description", u.Description); err != nil {
 		return errors.New("CANNOT SET DESCRIPTION")
 	}
-	if err := d.Set("object_permissions", d.Get("object_permissions").([]interface{})); err != nil {
+	if err := d.Set("object_permissions", flattenObjectPerms(u.ObjectPerms)); err != nil {
 		return errors.New("CANNOT SET OBJECT PERMISSIONS")
 	}
-	if err := d.Set("users", d.Get("users").([]interface{})); err != nil {
+	if err := d.Set("users", flattenUsers(u.Users)); err != nil {
 		return errors.New("CANNOT SET USERS")
 	}
-	if err := d.Set("groups", d.Get("groups").([]interface{})); err != nil {
+	if err := d.Set("groups", flattenGroups(u.Groups)); err != nil {
 		return errors.New("CANNOT SET GROUPS")
 	}
 	if err := d.Set("created", u.Created); err != nil {
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterRole_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_role", "role_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterRoleConfig("terraform-role", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_role.role", "role_id"),
+					resource.TestCheckResourceAttr("cloudcenter_role.role", "role_name", "terraform-role"),
+					resource.TestCheckResourceAttr("cloudcenter_role.role", "description", "First"),
+				),
+			},
+			{
+				Config: testAccCloudCenterRoleConfig("terraform-role", "Second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_role.role", "role_id"),
+					resource.TestCheckResourceAttr("cloudcenter_role.role", "description", "Second"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_role.role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterRole_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_role", "role_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterRoleConfig("terraform-role", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_role.role", "role_id"),
+					testAccCheckDisappears("cloudcenter_role.role", "role_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterRole_apiErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_role", "role_id"),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("POST", "roles", 409)
+				},
+				Config:      testAccCloudCenterRoleConfig("terraform-role", "First"),
+				ExpectError: regexp.MustCompile("409"),
+			},
+			{
+				Config: testAccCloudCenterRoleConfig("terraform-role", "First"),
+				Check:  testAccCheckExists("cloudcenter_role.role", "role_id"),
+			},
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("GET", "roles", 500)
+				},
+				Config:      testAccCloudCenterRoleConfig("terraform-role", "First"),
+				ExpectError: regexp.MustCompile("UNABLE TO RETRIEVE DETAILS FOR ROLE"),
+			},
+		},
+	})
+}
+
+func testAccCloudCenterRoleConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_role" "role" {
+    role_name   = "%s"
+    description = "%s"
+    tenant_id   = "1"
+    perms       = ["ALL_APPS_VIEW"]
+}
+`, name, description)
+}
+
+func TestResourceRoleStateUpgradeV0(t *testing.T) {
+
+	cases := []struct {
+		name     string
+		rawState map[string]interface{}
+		id       string
+	}{
+		{
+			name: "name based ID",
+			rawState: map[string]interface{}{
+				"id":        "1:terraform-role",
+				"role_id":   "5",
+				"role_name": "terraform-role",
+				"tenant_id": "1",
+			},
+			id: "1:5",
+		},
+		{
+			name: "missing role_id",
+			rawState: map[string]interface{}{
+				"id":        "1:terraform-role",
+				"role_name": "terraform-role",
+				"tenant_id": "1",
+			},
+			id: "1:terraform-role",
+		},
+	}
+
+	for _, c := range cases {
+
+		upgraded, err := resourceRoleStateUpgradeV0(c.rawState, nil)
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+
+		if upgraded["id"] != c.id {
+			t.Errorf("%s: expected ID %s, got %s", c.name, c.id, upgraded["id"])
+		}
+	}
+}
@@ -0,0 +1,364 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"log"
+	"strconv"
+)
+
+func resourceService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceCreate,
+		Read:   resourceServiceRead,
+		Update: resourceServiceUpdate,
+		Delete: resourceServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceServiceImport,
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"service_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"EXTERNAL", "VM", "CONTAINER"}, false),
+			},
+			"category": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"logo_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"image_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parameters": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"param_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"display_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "string",
+						},
+						"default_value": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"required": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"lifecycle_hooks": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hook_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"script": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceServiceCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	service, err := client.AddService(expandService(d))
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + service.Id)
+
+	return setServiceResourceData(d, service)
+}
+
+func resourceServiceRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR SERVICE - TENANT ID INCORRECT")
+	}
+
+	service_id_int, err := strconv.Atoi(d.Get("service_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR SERVICE - SERVICE ID INCORRECT")
+	}
+
+	service, err := client.GetService(tenant_id_int, service_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter service %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR SERVICE: " + err.Error())
+	}
+
+	return setServiceResourceData(d, service)
+}
+
+func resourceServiceUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newService := expandService(d)
+	newService.Id = d.Get("service_id").(string)
+
+	service, err := client.UpdateService(newService)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setServiceResourceData(d, service)
+}
+
+func resourceServiceDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR SERVICE - TENANT ID INCORRECT")
+	}
+
+	service_id_int, err := strconv.Atoi(d.Get("service_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR SERVICE - SERVICE ID INCORRECT")
+	}
+
+	err = client.DeleteService(tenant_id_int, service_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceServiceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, service_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("service_id", service_id); err != nil {
+		return nil, errors.New("CANNOT SET SERVICE ID")
+	}
+
+	if err := resourceServiceRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT SERVICE: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandService(d *schema.ResourceData) *cloudcenter.Service {
+
+	var serviceParameters []cloudcenter.ServiceParameter
+
+	for _, parameter := range d.Get("parameters").([]interface{}) {
+
+		p, _ := parameter.(map[string]interface{})
+
+		serviceParameters = append(serviceParameters, cloudcenter.ServiceParameter{
+			ParamName:    p["param_name"].(string),
+			DisplayName:  p["display_name"].(string),
+			Type:         p["type"].(string),
+			DefaultValue: p["default_value"].(string),
+			Required:     p["required"].(bool),
+		})
+	}
+
+	var lifecycleActions []cloudcenter.LifecycleAction
+
+	for _, hook := range d.Get("lifecycle_hooks").([]interface{}) {
+
+		h, _ := hook.(map[string]interface{})
+
+		lifecycleActions = append(lifecycleActions, cloudcenter.LifecycleAction{
+			Key:    h["hook_name"].(string),
+			Script: h["script"].(string),
+		})
+	}
+
+	return &cloudcenter.Service{
+
+		TenantId:          d.Get("tenant_id").(string),
+		Name:              d.Get("service_name").(string),
+		DisplayName:       d.Get("display_name").(string),
+		Description:       d.Get("description").(string),
+		ServiceType:       d.Get("service_type").(string),
+		Category:          d.Get("category").(string),
+		LogoPath:          d.Get("logo_path").(string),
+		ImageIds:          expandStringList(d.Get("image_ids").([]interface{})),
+		ServiceParameters: serviceParameters,
+		LifecycleActions:  lifecycleActions,
+	}
+}
+
+func setServiceResourceData(d *schema.ResourceData, u *cloudcenter.Service) error {
+
+	parameters := make([]interface{}, 0, len(u.ServiceParameters))
+
+	for _, parameter := range u.ServiceParameters {
+		parameters = append(parameters, map[string]interface{}{
+			"param_name":    parameter.ParamName,
+			"display_name":  parameter.DisplayName,
+			"type":          parameter.Type,
+			"default_value": parameter.DefaultValue,
+			"required":      parameter.Required,
+		})
+	}
+
+	lifecycleHooks := make([]interface{}, 0, len(u.LifecycleActions))
+
+	for _, lifecycleAction := range u.LifecycleActions {
+		lifecycleHooks = append(lifecycleHooks, map[string]interface{}{
+			"hook_name": lifecycleAction.Key,
+			"script":    lifecycleAction.Script,
+		})
+	}
+
+	if err := d.Set("service_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("service_name", u.Name); err != nil {
+		return errors.New("CANNOT SET SERVICE NAME")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("display_name", u.DisplayName); err != nil {
+		return errors.New("CANNOT SET DISPLAY NAME")
+	}
+	if err := d.Set("description", u.Description); err != nil {
+		return errors.New("CANNOT SET DESCRIPTION")
+	}
+	if err := d.Set("service_type", u.ServiceType); err != nil {
+		return errors.New("CANNOT SET SERVICE TYPE")
+	}
+	if err := d.Set("category", u.Category); err != nil {
+		return errors.New("CANNOT SET CATEGORY")
+	}
+	if err := d.Set("logo_path", u.LogoPath); err != nil {
+		return errors.New("CANNOT SET LOGO PATH")
+	}
+	if err := d.Set("image_ids", u.ImageIds); err != nil {
+		return errors.New("CANNOT SET IMAGE IDS")
+	}
+	if err := d.Set("parameters", parameters); err != nil {
+		return errors.New("CANNOT SET PARAMETERS")
+	}
+	if err := d.Set("lifecycle_hooks", lifecycleHooks); err != nil {
+		return errors.New("CANNOT SET LIFECYCLE HOOKS")
+	}
+	if err := d.Set("tenant_id", u.TenantId); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterService_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_service", "service_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterServiceConfig("terraform-service", "Terraform Service"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_service.service", "service_id"),
+					resource.TestCheckResourceAttr("cloudcenter_service.service", "service_name", "terraform-service"),
+					resource.TestCheckResourceAttr("cloudcenter_service.service", "display_name", "Terraform Service"),
+					resource.TestCheckResourceAttrPair("cloudcenter_service.service", "image_ids.0", "cloudcenter_image.image", "image_id"),
+					resource.TestCheckResourceAttr("cloudcenter_service.service", "lifecycle_hooks.0.hook_name", "INSTALL"),
+				),
+			},
+			{
+				Config: testAccCloudCenterServiceConfig("terraform-service", "Terraform Service v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_service.service", "service_id"),
+					resource.TestCheckResourceAttr("cloudcenter_service.service", "display_name", "Terraform Service v2"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_service.service",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterService_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_service", "service_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterServiceConfig("terraform-service", "Terraform Service"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_service.service", "service_id"),
+					testAccCheckDisappears("cloudcenter_service.service", "service_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterServiceConfig(name string, displayName string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_image" "image" {
+    image_name  = "terraform-image"
+    tenant_id   = "1"
+    os_name     = "Linux"
+    image_type  = "CENTOS"
+    num_of_nics = 1
+    enabled     = true
+}
+
+resource "cloudcenter_service" "service" {
+    service_name = "%s"
+    display_name = "%s"
+    service_type = "VM"
+    category     = "AppServer"
+    logo_path    = "https://example.com/logo.png"
+    image_ids    = ["${cloudcenter_image.image.image_id}"]
+    tenant_id    = "1"
+
+    parameters {
+        param_name    = "port"
+        display_name  = "Port"
+        default_value = "8080"
+        required      = true
+    }
+
+    lifecycle_hooks {
+        hook_name = "INSTALL"
+        script    = "service/install.sh"
+    }
+}
+`, name, displayName)
+}
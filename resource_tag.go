@@ -0,0 +1,281 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"strconv"
+)
+
+func resourceTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTagCreate,
+		Read:   resourceTagRead,
+		Update: resourceTagUpdate,
+		Delete: resourceTagDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceTagImport,
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
+		Schema: map[string]*schema.Schema{
+			"tag_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tag_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rules": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_ids": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cloud_ids": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"deployment_environment_ids": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTagCreate(d *schema.ResourceData, m interface{}) error {
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
+
+	newTag := cloudcenter.Tag{
+
+		TenantId:    d.Get("tenant_id").(string),
+		Name:        d.Get("tag_name").(string),
+		Description: d.Get("description").(string),
+		Rules:       expandTagRules(d.Get("rules").([]interface{})),
+	}
+
+	tag, err := client.AddTag(&newTag)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + tag.Id)
+
+	return setTagResourceData(d, tag)
+}
+
+func resourceTagRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR TAG - TENANT ID INCORRECT")
+	}
+
+	tag_id_int, err := strconv.Atoi(d.Get("tag_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR TAG - TAG ID INCORRECT")
+	}
+
+	tag, err := client.GetTag(tenant_id_int, tag_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter tag %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR TAG: " + err.Error())
+	}
+
+	return setTagResourceData(d, tag)
+}
+
+func resourceTagUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newTag := cloudcenter.Tag{
+
+		Id:          d.Get("tag_id").(string),
+		TenantId:    d.Get("tenant_id").(string),
+		Name:        d.Get("tag_name").(string),
+		Description: d.Get("description").(string),
+		Rules:       expandTagRules(d.Get("rules").([]interface{})),
+	}
+
+	tag, err := client.UpdateTag(&newTag)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setTagResourceData(d, tag)
+}
+
+func resourceTagDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR TAG - TENANT ID INCORRECT")
+	}
+
+	tag_id_int, err := strconv.Atoi(d.Get("tag_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR TAG - TAG ID INCORRECT")
+	}
+
+	err = client.DeleteTag(tenant_id_int, tag_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceTagImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	tenant_id, tag_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("tag_id", tag_id); err != nil {
+		return nil, errors.New("CANNOT SET TAG ID")
+	}
+
+	if err := resourceTagRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT TAG: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setTagResourceData(d *schema.ResourceData, u *cloudcenter.Tag) error {
+
+	if err := d.Set("tag_id", u.Id); err != nil {
+		return errors.New("CANNOT SET ID")
+	}
+	if err := d.Set("tag_name", u.Name); err != nil {
+		return errors.New("CANNOT SET TAG NAME")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("description", u.Description); err != nil {
+		return errors.New("CANNOT SET DESCRIPTION")
+	}
+	if err := d.Set("rules", flattenTagRules(u.Rules)); err != nil {
+		return errors.New("CANNOT SET RULES")
+	}
+	if err := d.Set("tenant_id", u.TenantId); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+
+	return nil
+}
+
+func expandTagRules(allRules []interface{}) []cloudcenter.TagRule {
+
+	var rules []cloudcenter.TagRule
+
+	for _, rule := range allRules {
+
+		r, _ := rule.(map[string]interface{})
+
+		rules = append(rules, cloudcenter.TagRule{
+			PolicyIds:      expandStringList(r["policy_ids"].([]interface{})),
+			CloudIds:       expandStringList(r["cloud_ids"].([]interface{})),
+			EnvironmentIds: expandStringList(r["deployment_environment_ids"].([]interface{})),
+		})
+	}
+
+	return rules
+}
+
+func flattenTagRules(rules []cloudcenter.TagRule) []interface{} {
+
+	result := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"policy_ids":                 rule.PolicyIds,
+			"cloud_ids":                  rule.CloudIds,
+			"deployment_environment_ids": rule.EnvironmentIds,
+		})
+	}
+
+	return result
+}
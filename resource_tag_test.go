@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterTag_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_tag", "tag_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterTagConfig("terraform-tag", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_tag.tag", "tag_id"),
+					resource.TestCheckResourceAttr("cloudcenter_tag.tag", "tag_name", "terraform-tag"),
+					resource.TestCheckResourceAttr("cloudcenter_tag.tag", "description", "First"),
+					resource.TestCheckResourceAttr("cloudcenter_tag.tag", "rules.0.cloud_ids.0", "5"),
+				),
+			},
+			{
+				Config: testAccCloudCenterTagConfig("terraform-tag", "Second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_tag.tag", "tag_id"),
+					resource.TestCheckResourceAttr("cloudcenter_tag.tag", "description", "Second"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_tag.tag",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterTag_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_tag", "tag_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterTagConfig("terraform-tag", "First"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_tag.tag", "tag_id"),
+					testAccCheckDisappears("cloudcenter_tag.tag", "tag_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterTagConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_tag" "tag" {
+    tag_name    = "%s"
+    description = "%s"
+    tenant_id   = "1"
+
+    rules {
+        policy_ids                 = ["4"]
+        cloud_ids                  = ["5"]
+        deployment_environment_ids = ["6"]
+    }
+}
+`, name, description)
+}
@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"strconv"
+)
+
+func resourceTenant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTenantCreate,
+		Read:   resourceTenantRead,
+		Update: resourceTenantUpdate,
+		Delete: resourceTenantDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceTenantImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parent_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"contact_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_activation_profile_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"disabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceTenantCreate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newTenant := cloudcenter.Tenant{
+
+		Name:                d.Get("tenant_name").(string),
+		ParentTenantId:      d.Get("parent_tenant_id").(string),
+		DomainName:          d.Get("domain_name").(string),
+		ContactEmail:        d.Get("contact_email").(string),
+		DefaultActivationId: d.Get("default_activation_profile_id").(string),
+		Disabled:            d.Get("disabled").(bool),
+	}
+
+	tenant, err := client.AddTenant(&newTenant)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	d.SetId(d.Get("parent_tenant_id").(string) + ":" + tenant.Id)
+
+	return setTenantResourceData(d, tenant)
+}
+
+func resourceTenantRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR TENANT - TENANT ID INCORRECT")
+	}
+
+	tenant, err := client.GetTenant(tenant_id_int)
+
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] CloudCenter tenant %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR TENANT: " + err.Error())
+	}
+
+	return setTenantResourceData(d, tenant)
+}
+
+func resourceTenantUpdate(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	newTenant := cloudcenter.Tenant{
+
+		Id:                  d.Get("tenant_id").(string),
+		Name:                d.Get("tenant_name").(string),
+		ParentTenantId:      d.Get("parent_tenant_id").(string),
+		DomainName:          d.Get("domain_name").(string),
+		ContactEmail:        d.Get("contact_email").(string),
+		DefaultActivationId: d.Get("default_activation_profile_id").(string),
+		Disabled:            d.Get("disabled").(bool),
+	}
+
+	tenant, err := client.UpdateTenant(&newTenant)
+
+	if err != nil {
+		return errors.New(err.Error())
+	}
+
+	return setTenantResourceData(d, tenant)
+}
+
+func resourceTenantDelete(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*providerMeta).client
+
+	tenant_id_int, err := strconv.Atoi(d.Get("tenant_id").(string))
+
+	if err != nil {
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR TENANT - TENANT ID INCORRECT")
+	}
+
+	err = client.DeleteTenant(tenant_id_int)
+
+	if err != nil && !isNotFound(err) {
+		return errors.New(err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceTenantImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	parent_tenant_id, tenant_id, err := parseImportId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("parent_tenant_id", parent_tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET PARENT TENANT ID")
+	}
+	if err := d.Set("tenant_id", tenant_id); err != nil {
+		return nil, errors.New("CANNOT SET TENANT ID")
+	}
+
+	if err := resourceTenantRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("CANNOT IMPORT TENANT: NOT FOUND")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setTenantResourceData(d *schema.ResourceData, u *cloudcenter.Tenant) error {
+
+	if err := d.Set("tenant_id", u.Id); err != nil {
+		return errors.New("CANNOT SET TENANT ID")
+	}
+	if err := d.Set("tenant_name", u.Name); err != nil {
+		return errors.New("CANNOT SET TENANT NAME")
+	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("parent_tenant_id", u.ParentTenantId); err != nil {
+		return errors.New("CANNOT SET PARENT TENANT ID")
+	}
+	if err := d.Set("domain_name", u.DomainName); err != nil {
+		return errors.New("CANNOT SET DOMAIN NAME")
+	}
+	if err := d.Set("contact_email", u.ContactEmail); err != nil {
+		return errors.New("CANNOT SET CONTACT EMAIL")
+	}
+	if err := d.Set("default_activation_profile_id", u.DefaultActivationId); err != nil {
+		return errors.New("CANNOT SET DEFAULT ACTIVATION PROFILE ID")
+	}
+	if err := d.Set("disabled", u.Disabled); err != nil {
+		return errors.New("CANNOT SET DISABLED")
+	}
+
+	return nil
+}
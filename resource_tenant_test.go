@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccCloudCenterTenant_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_tenant", "tenant_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterTenantConfig("terraform-tenant", "admin@customer.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_tenant.tenant", "tenant_id"),
+					resource.TestCheckResourceAttr("cloudcenter_tenant.tenant", "tenant_name", "terraform-tenant"),
+					resource.TestCheckResourceAttr("cloudcenter_tenant.tenant", "contact_email", "admin@customer.com"),
+				),
+			},
+			{
+				Config: testAccCloudCenterTenantConfig("terraform-tenant", "ops@customer.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_tenant.tenant", "tenant_id"),
+					resource.TestCheckResourceAttr("cloudcenter_tenant.tenant", "contact_email", "ops@customer.com"),
+				),
+			},
+			{
+				ResourceName:      "cloudcenter_tenant.tenant",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterTenant_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_tenant", "tenant_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterTenantConfig("terraform-tenant", "admin@customer.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_tenant.tenant", "tenant_id"),
+					testAccCheckDisappears("cloudcenter_tenant.tenant", "tenant_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCloudCenterTenantConfig(name string, contactEmail string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_tenant" "tenant" {
+    tenant_name      = "%s"
+    contact_email    = "%s"
+    parent_tenant_id = "1"
+    domain_name      = "customer.com"
+}
+`, name, contactEmail)
+}
@@ -23,6 +23,8 @@ import (
 	"errors"
 	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
 	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"strings"
 )
 
 func resourceUser() *schema.Resource {
@@ -32,6 +34,21 @@ func resourceUser() *schema.Resource {
 		Update: resourceUserUpdate,
 		Delete: resourceUserDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceUserImport,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceUserV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceUserStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		CustomizeDiff: customizeDiffTenantId,
+
 		Schema: map[string]*schema.Schema{
 			"user_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -47,9 +64,10 @@ func resourceUser() *schema.Resource {
 			},
 
 			"password": &schema.Schema{
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password the user is created with. It is never read back, so an imported user keeps their password until this is changed",
 			},
 			"email_address": &schema.Schema{
 				Type:     schema.TypeString,
@@ -57,11 +75,18 @@ func resourceUser() *schema.Resource {
 			},
 			"tenant_id": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"enabled": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 			},
 			"type": &schema.Schema{
 				Type:     schema.TypeString,
@@ -82,6 +107,7 @@ func resourceUser() *schema.Resource {
 			"email_verified": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 			},
 			"phone_number": &schema.Schema{
 				Type:     schema.TypeString,
@@ -90,14 +116,17 @@ func resourceUser() *schema.Resource {
 			"external_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"access_keys": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"disable_reason": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"account_source": &schema.Schema{
 				Type:     schema.TypeString,
@@ -106,14 +135,17 @@ func resourceUser() *schema.Resource {
 			"status": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"detail": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"activation_data": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"created": &schema.Schema{
 				Type:     schema.TypeInt,
@@ -126,6 +158,7 @@ func resourceUser() *schema.Resource {
 			"co_admin": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 			},
 			"tenant_admin": &schema.Schema{
 				Type:     schema.TypeBool,
@@ -144,20 +177,35 @@ func resourceUser() *schema.Resource {
 }
 
 func resourceUserCreate(d *schema.ResourceData, m interface{}) error {
-	email_address := d.Get("email_address").(string)
-	d.SetId(email_address)
 
-	client := m.(*cloudcenter.Client)
+	if d.Get("password").(string) == "" {
+		return errors.New("password MUST BE SET WHEN CREATING A USER")
+	}
+
+	if err := setResourceDefaultTenantId(d, m); err != nil {
+		return err
+	}
+
+	client := m.(*providerMeta).client
 
 	newUser := cloudcenter.User{
 
-		FirstName:   d.Get("first_name").(string),
-		LastName:    d.Get("last_name").(string),
-		Password:    d.Get("password").(string),
-		EmailAddr:   d.Get("email_address").(string),
-		CompanyName: d.Get("company_name").(string),
-		PhoneNumber: d.Get("phone_number").(string),
-		TenantId:    d.Get("tenant_id").(string),
+		FirstName:      d.Get("first_name").(string),
+		LastName:       d.Get("last_name").(string),
+		Password:       d.Get("password").(string),
+		EmailAddr:      d.Get("email_address").(string),
+		CompanyName:    d.Get("company_name").(string),
+		PhoneNumber:    d.Get("phone_number").(string),
+		TenantId:       d.Get("tenant_id").(string),
+		Enabled:        d.Get("enabled").(bool),
+		EmailVerified:  d.Get("email_verified").(bool),
+		ExternalId:     d.Get("external_id").(string),
+		AccessKeys:     d.Get("access_keys").(string),
+		DisableReason:  d.Get("disable_reason").(string),
+		Status:         d.Get("status").(string),
+		Detail:         d.Get("detail").(string),
+		ActivationData: d.Get("activation_data").(string),
+		CoAdmin:        d.Get("co_admin").(bool),
 	}
 
 	user, err := client.AddUser(&newUser)
@@ -166,14 +214,21 @@ func resourceUserCreate(d *schema.ResourceData, m interface{}) error {
 		return errors.New(err.Error())
 	}
 
+	d.SetId(user.TenantId + ":" + user.Id)
+
 	return setUserResourceData(d, user)
 }
 
 func resourceUserRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*cloudcenter.Client)
-	user, err := client.GetUserFromEmail(d.Get("email_address").(string))
+	client := m.(*providerMeta).client
+	user, err := findUserByEmail(client, d.Get("email_address").(string))
 	if err != nil {
-		return errors.New("UNABLE TO RETRIEVE DETAILS FOR USER: " + d.Get("username").(string))
+		return errors.New("UNABLE TO RETRIEVE DETAILS FOR USER: " + d.Get("username").(string) + ": " + err.Error())
+	}
+	if user == nil {
+		log.Printf("[WARN] CloudCenter user %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
 
 	return setUserResourceData(d, user)
@@ -181,20 +236,34 @@ func resourceUserRead(d *schema.ResourceData, m interface{}) error {
 
 func resourceUserUpdate(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
 	newUser := cloudcenter.User{
-		Id:            d.Get("user_id").(string),
-		FirstName:     d.Get("first_name").(string),
-		LastName:      d.Get("last_name").(string),
-		Password:      d.Get("password").(string),
-		EmailAddr:     d.Get("email_address").(string),
-		CompanyName:   d.Get("company_name").(string),
-		PhoneNumber:   d.Get("phone_number").(string),
-		TenantId:      d.Get("tenant_id").(string),
-		Username:      d.Get("username").(string),
-		AccountSource: d.Get("account_source").(string),
-		Type:          d.Get("type").(string),
+		Id:             d.Get("user_id").(string),
+		FirstName:      d.Get("first_name").(string),
+		LastName:       d.Get("last_name").(string),
+		EmailAddr:      d.Get("email_address").(string),
+		CompanyName:    d.Get("company_name").(string),
+		PhoneNumber:    d.Get("phone_number").(string),
+		TenantId:       d.Get("tenant_id").(string),
+		Username:       d.Get("username").(string),
+		AccountSource:  d.Get("account_source").(string),
+		Type:           d.Get("type").(string),
+		Enabled:        d.Get("enabled").(bool),
+		EmailVerified:  d.Get("email_verified").(bool),
+		ExternalId:     d.Get("external_id").(string),
+		AccessKeys:     d.Get("access_keys").(string),
+		DisableReason:  d.Get("disable_reason").(string),
+		Status:         d.Get("status").(string),
+		Detail:         d.Get("detail").(string),
+		ActivationData: d.Get("activation_data").(string),
+		CoAdmin:        d.Get("co_admin").(bool),
+	}
+
+	// Only send the password when it changes, so that updating an imported
+	// user does not reset it.
+	if d.HasChange("password") {
+		newUser.Password = d.Get("password").(string)
 	}
 
 	user, err := client.UpdateUser(&newUser)
@@ -208,18 +277,72 @@ func resourceUserUpdate(d *schema.ResourceData, m interface{}) error {
 
 func resourceUserDelete(d *schema.ResourceData, m interface{}) error {
 
-	client := m.(*cloudcenter.Client)
+	client := m.(*providerMeta).client
 
-	err := client.DeleteUserByEmail(d.Get("email_address").(string))
+	user, err := findUserByEmail(client, d.Get("email_address").(string))
 
 	if err != nil {
 		return errors.New(err.Error())
 	}
 
+	if user != nil {
+		err = client.DeleteUserByEmail(user.EmailAddr)
+
+		if err != nil && !isNotFound(err) {
+			return errors.New(err.Error())
+		}
+	}
+
 	d.SetId("")
 	return nil
 }
 
+// findUserByEmail looks a user up in the list of users. Unlike
+// GetUserFromEmail it returns nil rather than an error when no user has the
+// email address, so that a user deleted outside of Terraform can be told
+// apart from a failed request.
+func findUserByEmail(client *cloudcenter.Client, email_address string) (*cloudcenter.User, error) {
+
+	users, err := client.GetUsers()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range users {
+		if users[i].EmailAddr == email_address {
+			return &users[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceUserImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	email_address := d.Id()
+
+	if !strings.Contains(email_address, "@") {
+		return nil, errors.New("IMPORT ID MUST BE THE EMAIL ADDRESS OF THE USER, GOT: " + email_address)
+	}
+
+	if err := d.Set("email_address", email_address); err != nil {
+		return nil, errors.New("CANNOT SET EMAIL")
+	}
+
+	if err := resourceUserRead(d, m); err != nil {
+		return nil, err
+	}
+
+	if d.Get("user_id").(string) == "" {
+		return nil, errors.New("CANNOT IMPORT USER: NOT FOUND")
+	}
+
+	d.SetId(d.Get("tenant_id").(string) + ":" + d.Get("user_id").(string))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setUserResourceData(d *schema.ResourceData, u *cloudcenter.User) error {
 
 	if err := d.Set("user_id", u.Id); err != nil {
@@ -246,5 +369,56 @@ func setUserResourceData(d *schema.ResourceData, u *cloudcenter.User) error {
 	if err := d.Set("account_source", u.AccountSource); err != nil {
 		return errors.New("CANNOT SET ACCOUNT SOURCE")
 	}
+	if err := d.Set("resource", u.Resource); err != nil {
+		return errors.New("CANNOT SET RESOURCE")
+	}
+	if err := d.Set("company_name", u.CompanyName); err != nil {
+		return errors.New("CANNOT SET COMPANY NAME")
+	}
+	if err := d.Set("phone_number", u.PhoneNumber); err != nil {
+		return errors.New("CANNOT SET PHONE NUMBER")
+	}
+	if err := d.Set("created", u.Created); err != nil {
+		return errors.New("CANNOT SET CREATED VALUE")
+	}
+	if err := d.Set("last_updated", u.LastUpdated); err != nil {
+		return errors.New("CANNOT SET LAST UPDATED VALUE")
+	}
+	if err := d.Set("tenant_admin", u.TenantAdmin); err != nil {
+		return errors.New("CANNOT SET TENANT ADMIN")
+	}
+	if err := d.Set("activation_profile_id", u.ActivationProfileId); err != nil {
+		return errors.New("CANNOT SET ACTIVATION PROFILE ID")
+	}
+	if err := d.Set("has_subscription_plan", u.HasSubscriptionPlanType); err != nil {
+		return errors.New("CANNOT SET HAS SUBSCRIPTION PLAN")
+	}
+	if err := d.Set("enabled", u.Enabled); err != nil {
+		return errors.New("CANNOT SET ENABLED")
+	}
+	if err := d.Set("email_verified", u.EmailVerified); err != nil {
+		return errors.New("CANNOT SET EMAIL VERIFIED")
+	}
+	if err := d.Set("external_id", u.ExternalId); err != nil {
+		return errors.New("CANNOT SET EXTERNAL ID")
+	}
+	if err := d.Set("access_keys", u.AccessKeys); err != nil {
+		return errors.New("CANNOT SET ACCESS KEYS")
+	}
+	if err := d.Set("disable_reason", u.DisableReason); err != nil {
+		return errors.New("CANNOT SET DISABLE REASON")
+	}
+	if err := d.Set("status", u.Status); err != nil {
+		return errors.New("CANNOT SET STATUS")
+	}
+	if err := d.Set("detail", u.Detail); err != nil {
+		return errors.New("CANNOT SET DETAIL")
+	}
+	if err := d.Set("activation_data", u.ActivationData); err != nil {
+		return errors.New("CANNOT SET ACTIVATION DATA")
+	}
+	if err := d.Set("co_admin", u.CoAdmin); err != nil {
+		return errors.New("CANNOT SET CO ADMIN")
+	}
 	return nil
 }
@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceUserV0 is the schema of cloudcenter_user at schema version 0. It
+// must not be changed, as it is used to decode state written by older
+// versions of the provider.
+func resourceUserV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"email_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"first_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"company_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"email_verified": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"phone_number": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"external_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"access_keys": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"disable_reason": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"account_source": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"detail": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"activation_data": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"created": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"co_admin": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tenant_admin": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"activation_profile_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"has_subscription_plan": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceUserStateUpgradeV0 rewrites the ID to <tenant_id>:<user_id>.
+// State created before version 1 used the email address as the ID.
+func resourceUserStateUpgradeV0(rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+
+	user_id, _ := rawState["user_id"].(string)
+
+	if user_id == "" {
+		return rawState, nil
+	}
+
+	rawState["id"] = rawStateString(rawState["tenant_id"]) + ":" + user_id
+
+	return rawState, nil
+}
@@ -0,0 +1,255 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccCloudCenterUser_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_user", "user_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterUserConfig("terraform@mydomain.com", "Company"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_user.user", "user_id"),
+					resource.TestCheckResourceAttr("cloudcenter_user.user", "email_address", "terraform@mydomain.com"),
+					resource.TestCheckResourceAttr("cloudcenter_user.user", "company_name", "Company"),
+					resource.TestCheckResourceAttr("cloudcenter_user.user", "external_id", "terraform-external"),
+					resource.TestCheckResourceAttr("cloudcenter_user.user", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCloudCenterUserConfig("terraform@mydomain.com", "Other Company"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_user.user", "user_id"),
+					resource.TestCheckResourceAttr("cloudcenter_user.user", "company_name", "Other Company"),
+				),
+			},
+			{
+				ResourceName:            "cloudcenter_user.user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           "terraform@mydomain.com",
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterUser_importNotFound(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_user", "user_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterUserConfig("terraform@mydomain.com", "Company"),
+				Check:  testAccCheckExists("cloudcenter_user.user", "user_id"),
+			},
+			{
+				ResourceName:  "cloudcenter_user.user",
+				ImportState:   true,
+				ImportStateId: "missing@mydomain.com",
+				ExpectError:   regexp.MustCompile("CANNOT IMPORT USER: NOT FOUND"),
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterUser_disappears(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_user", "user_id"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudCenterUserConfig("terraform@mydomain.com", "Company"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists("cloudcenter_user.user", "user_id"),
+					testAccCheckDisappears("cloudcenter_user.user", "user_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudCenterUser_apiErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDestroy("cloudcenter_user", "user_id"),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("POST", "users", 409)
+				},
+				Config:      testAccCloudCenterUserConfig("terraform@mydomain.com", "Company"),
+				ExpectError: regexp.MustCompile("409"),
+			},
+			{
+				Config: testAccCloudCenterUserConfig("terraform@mydomain.com", "Company"),
+				Check:  testAccCheckExists("cloudcenter_user.user", "user_id"),
+			},
+			{
+				PreConfig: func() {
+					testAccCCM.injectFault("GET", "users", 500)
+				},
+				Config:      testAccCloudCenterUserConfig("terraform@mydomain.com", "Company"),
+				ExpectError: regexp.MustCompile("UNABLE TO RETRIEVE DETAILS FOR USER"),
+			},
+		},
+	})
+}
+
+func TestResourceUserUpdate_importedKeepsPassword(t *testing.T) {
+
+	provider := Provider()
+
+	if err := provider.Configure(terraform.NewResourceConfigRaw(nil)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	meta := provider.Meta()
+	r := resourceUser()
+
+	config := map[string]interface{}{
+		"email_address": "imported@mydomain.com",
+		"company_name":  "Company",
+		"first_name":    "Terraform",
+		"last_name":     "Plugin",
+		"password":      "myPassword",
+		"tenant_id":     "1",
+	}
+
+	diff, err := r.Diff(nil, terraform.NewResourceConfigRaw(config), meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	created, err := r.Apply(&terraform.InstanceState{}, diff, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	user_id := created.Attributes["user_id"]
+
+	defer testAccCCM.remove(user_id)
+
+	// Import the user, which leaves the password out of state, then update
+	// it from a configuration that leaves the password out too.
+	imported, err := resourceUserImport(r.Data(&terraform.InstanceState{ID: "imported@mydomain.com"}), meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	delete(config, "password")
+	config["company_name"] = "Other Company"
+
+	if _, errs := r.Validate(terraform.NewResourceConfigRaw(config)); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %s", errs)
+	}
+
+	diff, err = r.Diff(imported[0].State(), terraform.NewResourceConfigRaw(config), meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := diff.Attributes["password"]; ok {
+		t.Errorf("expected no password change, got %#v", diff.Attributes["password"])
+	}
+
+	if _, err := r.Apply(imported[0].State(), diff, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if company := testAccCCM.get(user_id, "companyName"); company != "Other Company" {
+		t.Errorf("expected company Other Company, got %v", company)
+	}
+
+	if password := testAccCCM.get(user_id, "password"); password != "myPassword" {
+		t.Errorf("expected password myPassword to be kept, got %v", password)
+	}
+}
+
+func testAccCloudCenterUserConfig(name string, companyName string) string {
+	return fmt.Sprintf(`
+resource "cloudcenter_user" "user" {
+    email_address = "%s"
+    company_name  = "%s"
+    first_name    = "Terraform"
+    last_name     = "Plugin"
+    password      = "myPassword"
+    external_id   = "terraform-external"
+    enabled       = true
+    tenant_id     = "1"
+}
+`, name, companyName)
+}
+
+func TestResourceUserStateUpgradeV0(t *testing.T) {
+
+	cases := []struct {
+		name     string
+		rawState map[string]interface{}
+		id       string
+	}{
+		{
+			name: "email address ID",
+			rawState: map[string]interface{}{
+				"id":            "terraform@mydomain.com",
+				"user_id":       "5",
+				"email_address": "terraform@mydomain.com",
+				"tenant_id":     "1",
+			},
+			id: "1:5",
+		},
+		{
+			name: "missing user_id",
+			rawState: map[string]interface{}{
+				"id":            "terraform@mydomain.com",
+				"email_address": "terraform@mydomain.com",
+				"tenant_id":     "1",
+			},
+			id: "terraform@mydomain.com",
+		},
+	}
+
+	for _, c := range cases {
+
+		upgraded, err := resourceUserStateUpgradeV0(c.rawState, nil)
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+
+		if upgraded["id"] != c.id {
+			t.Errorf("%s: expected ID %s, got %s", c.name, c.id, upgraded["id"])
+		}
+	}
+}
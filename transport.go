@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// retryMinBackoff and retryMaxBackoff bound the exponential backoff between
+// attempts. They are variables so that tests can shorten them.
+var retryMinBackoff = 1 * time.Second
+var retryMaxBackoff = 30 * time.Second
+
+// apiError is the error returned for a response from the CloudCenter Manager
+// with an error status, so that callers can act on the status code rather
+// than on the wording of the message.
+type apiError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Message)
+}
+
+// statusTransport turns responses with a 4xx or 5xx status into an *apiError,
+// which the client passes back to the provider unchanged.
+type statusTransport struct {
+	next http.RoundTripper
+}
+
+func (t *statusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	resp, err := t.next.RoundTrip(req)
+
+	if err != nil || resp.StatusCode < 400 {
+		return resp, err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, &apiError{
+		StatusCode: resp.StatusCode,
+		Message:    string(body),
+	}
+}
+
+// retryTransport retries requests that fail while the CloudCenter Manager is
+// overloaded or restarting, such as during an upgrade.
+//
+// Idempotent requests are retried after a temporary network error or any 429
+// or 5xx response. Other requests, such as the POST that creates an object, are only
+// retried after a 429 or 503, as the CCM has then not acted on them.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	for attempt := 0; ; attempt++ {
+
+		attemptReq := req
+
+		if attempt > 0 && req.Body != nil {
+
+			body, err := req.GetBody()
+
+			if err != nil {
+				return nil, err
+			}
+
+			retryReq := *req
+			retryReq.Body = body
+			attemptReq = &retryReq
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+
+		if attempt >= t.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		if req.Body != nil && req.GetBody == nil {
+			return resp, err
+		}
+
+		wait := retryBackoff(attempt, resp)
+
+		if err != nil {
+			log.Printf("[WARN] CloudCenter request %s %s failed, retrying in %s: %s", req.Method, req.URL, wait, err)
+		} else {
+			log.Printf("[WARN] CloudCenter request %s %s returned %d, retrying in %s", req.Method, req.URL, resp.StatusCode, wait)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// shouldRetry reports whether a request that produced resp or err is worth
+// sending again.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+
+	idempotent := req.Method == http.MethodGet ||
+		req.Method == http.MethodHead ||
+		req.Method == http.MethodOptions ||
+		req.Method == http.MethodPut ||
+		req.Method == http.MethodDelete
+
+	if err != nil {
+		return idempotent && isTemporaryNetError(err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		return true
+	}
+
+	return idempotent && resp.StatusCode >= 500
+}
+
+// isTemporaryNetError reports whether err is a network error that may clear
+// up, such as a timeout or a refused connection while the CCM restarts.
+// Errors such as an untrusted certificate would fail every attempt.
+func isTemporaryNetError(err error) bool {
+
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && (netErr.Timeout() || netErr.Temporary())
+}
+
+// retryBackoff returns how long to wait before the next attempt, honouring a
+// Retry-After header given in seconds. The wait never exceeds
+// retryMaxBackoff, even when the CCM asks for longer.
+func retryBackoff(attempt int, resp *http.Response) time.Duration {
+
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			if time.Duration(seconds) > retryMaxBackoff/time.Second {
+				return retryMaxBackoff
+			}
+			return time.Duration(seconds) * time.Second
+		}
+	}
+
+	wait := retryMinBackoff << uint(attempt)
+
+	if wait > retryMaxBackoff || wait <= 0 {
+		wait = retryMaxBackoff
+	}
+
+	return wait
+}
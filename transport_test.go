@@ -0,0 +1,222 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testRetryServer fails the first failures requests with status, then
+// succeeds, counting every request it receives.
+func testRetryServer(failures int, status int, attempts *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*attempts++
+		if *attempts <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+// setRetryBackoff sets the backoff bounds for a test and returns a function
+// that restores the previous ones.
+func setRetryBackoff(min time.Duration, max time.Duration) func() {
+
+	oldMin, oldMax := retryMinBackoff, retryMaxBackoff
+
+	retryMinBackoff, retryMaxBackoff = min, max
+
+	return func() {
+		retryMinBackoff, retryMaxBackoff = oldMin, oldMax
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+
+	defer setRetryBackoff(time.Millisecond, 5*time.Millisecond)()
+
+	cases := []struct {
+		name       string
+		method     string
+		status     int
+		failures   int
+		maxRetries int
+		attempts   int
+		finalCode  int
+	}{
+		{"get retried after 502", http.MethodGet, http.StatusBadGateway, 2, 3, 3, http.StatusOK},
+		{"get gives up after max retries", http.MethodGet, http.StatusServiceUnavailable, 5, 2, 3, http.StatusServiceUnavailable},
+		{"post retried after 429", http.MethodPost, http.StatusTooManyRequests, 1, 3, 2, http.StatusOK},
+		{"post retried after 503", http.MethodPost, http.StatusServiceUnavailable, 1, 3, 2, http.StatusOK},
+		{"post not retried after 500", http.MethodPost, http.StatusInternalServerError, 1, 3, 1, http.StatusInternalServerError},
+		{"delete not retried after 404", http.MethodDelete, http.StatusNotFound, 1, 3, 1, http.StatusNotFound},
+		{"retries disabled", http.MethodGet, http.StatusBadGateway, 1, 0, 1, http.StatusBadGateway},
+	}
+
+	for _, c := range cases {
+
+		attempts := 0
+		server := testRetryServer(c.failures, c.status, &attempts)
+
+		client := &http.Client{
+			Transport: &retryTransport{next: http.DefaultTransport, maxRetries: c.maxRetries},
+		}
+
+		req, _ := http.NewRequest(c.method, server.URL, strings.NewReader("{}"))
+		resp, err := client.Do(req)
+
+		server.Close()
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+
+		resp.Body.Close()
+
+		if attempts != c.attempts {
+			t.Errorf("%s: expected %d attempts, got %d", c.name, c.attempts, attempts)
+		}
+
+		if resp.StatusCode != c.finalCode {
+			t.Errorf("%s: expected final status %d, got %d", c.name, c.finalCode, resp.StatusCode)
+		}
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+
+	defer setRetryBackoff(time.Second, 30*time.Second)()
+
+	expected := []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second}
+
+	for attempt, wait := range expected {
+		if got := retryBackoff(attempt, nil); got != wait {
+			t.Errorf("attempt %d: expected %s, got %s", attempt, wait, got)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+
+	if got := retryBackoff(0, resp); got != 7*time.Second {
+		t.Errorf("expected Retry-After to be honoured, got %s", got)
+	}
+
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+
+	if got := retryBackoff(0, resp); got != retryMaxBackoff {
+		t.Errorf("expected Retry-After to be capped at %s, got %s", retryMaxBackoff, got)
+	}
+}
+
+func TestStatusTransport_notFound(t *testing.T) {
+
+	cases := []struct {
+		name     string
+		status   int
+		body     string
+		notFound bool
+	}{
+		{"404 response", http.StatusNotFound, `{"errors":[{"message":"OBJECT 1 NOT FOUND"}]}`, true},
+		{"500 response mentioning 404", http.StatusInternalServerError, `{"errors":[{"message":"UPSTREAM RETURNED 404 NOT FOUND"}]}`, false},
+		{"400 response", http.StatusBadRequest, `{"errors":[{"message":"INVALID NAME"}]}`, false},
+	}
+
+	for _, c := range cases {
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(c.status)
+			w.Write([]byte(c.body))
+		}))
+
+		client := &http.Client{
+			Transport: &statusTransport{next: http.DefaultTransport},
+		}
+
+		_, err := client.Get(server.URL)
+
+		server.Close()
+
+		if err == nil {
+			t.Fatalf("%s: expected an error", c.name)
+		}
+
+		if !strings.Contains(err.Error(), c.body) {
+			t.Errorf("%s: expected the error to include the response body, got %s", c.name, err)
+		}
+
+		if isNotFound(err) != c.notFound {
+			t.Errorf("%s: expected isNotFound to be %t for %s", c.name, c.notFound, err)
+		}
+	}
+}
+
+// countingTransport counts the requests passed on to the next transport.
+type countingTransport struct {
+	next     http.RoundTripper
+	attempts int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.attempts++
+	return t.next.RoundTrip(req)
+}
+
+func TestRetryTransport_networkErrors(t *testing.T) {
+
+	defer setRetryBackoff(time.Millisecond, 5*time.Millisecond)()
+
+	// A server with a certificate the client does not trust.
+	untrusted := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer untrusted.Close()
+
+	// An address on which nothing is listening any more.
+	closed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	closed.Close()
+
+	cases := []struct {
+		name     string
+		url      string
+		attempts int
+	}{
+		{"certificate error not retried", untrusted.URL, 1},
+		{"connection refused retried", closed.URL, 3},
+	}
+
+	for _, c := range cases {
+
+		counter := &countingTransport{next: &http.Transport{}}
+
+		client := &http.Client{
+			Transport: &retryTransport{next: counter, maxRetries: 2},
+		}
+
+		if _, err := client.Get(c.url); err == nil {
+			t.Fatalf("%s: expected an error", c.name)
+		}
+
+		if counter.attempts != c.attempts {
+			t.Errorf("%s: expected %d attempts, got %d", c.name, c.attempts, counter.attempts)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright (c) 2019 Cisco and/or its affiliates.
+
+This software is licensed to you under the terms of the Cisco Sample
+Code License, Version 1.0 (the "License"). You may obtain a copy of the
+License at
+
+               https://developer.cisco.com/docs/licenses
+
+All use of the material herein must be in accordance with the terms of
+the License. All rights not expressly granted by the License are
+reserved. Unless required by applicable law or agreed to separately in
+writing, software distributed under the License is distributed on an "AS
+IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+or implied.
+
+*/
+
+package main
+
+import (
+	"errors"
+	"github.com/cloudcenter-clientlibrary-go/cloudcenter"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// parseImportId splits an import ID of the form <tenant_id>:<object_id>
+// and checks that both parts are numeric CloudCenter IDs.
+func parseImportId(id string) (string, string, error) {
+
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 2 {
+		return "", "", errors.New("IMPORT ID MUST BE IN THE FORMAT <tenant_id>:<object_id>, GOT: " + id)
+	}
+
+	if _, err := strconv.Atoi(parts[0]); err != nil {
+		return "", "", errors.New("IMPORT ID CONTAINS AN INVALID TENANT ID: " + parts[0])
+	}
+
+	if _, err := strconv.Atoi(parts[1]); err != nil {
+		return "", "", errors.New("IMPORT ID CONTAINS AN INVALID OBJECT ID: " + parts[1])
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// parseNestedImportId splits an import ID of the form
+// <tenant_id>:<parent_id>:<object_id>, used for objects that live under
+// another object such as a cloud.
+func parseNestedImportId(id string) (string, string, string, error) {
+
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 3 {
+		return "", "", "", errors.New("IMPORT ID MUST BE IN THE FORMAT <tenant_id>:<parent_id>:<object_id>, GOT: " + id)
+	}
+
+	for _, part := range parts {
+		if _, err := strconv.Atoi(part); err != nil {
+			return "", "", "", errors.New("IMPORT ID CONTAINS AN INVALID ID: " + part)
+		}
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+// parseRegionImportId splits an import ID of the form
+// <tenant_id>:<cloud_id>:<region_id>:<object_id>, used for objects that live
+// under a cloud region such as image mappings.
+func parseRegionImportId(id string) (string, string, string, string, error) {
+
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 4 {
+		return "", "", "", "", errors.New("IMPORT ID MUST BE IN THE FORMAT <tenant_id>:<cloud_id>:<region_id>:<object_id>, GOT: " + id)
+	}
+
+	for _, part := range parts {
+		if _, err := strconv.Atoi(part); err != nil {
+			return "", "", "", "", errors.New("IMPORT ID CONTAINS AN INVALID ID: " + part)
+		}
+	}
+
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+// isNotFound reports whether err is the CCM's 404 response to a request for
+// an object that does not exist, e.g. one deleted outside of Terraform. The
+// 404 is turned into an *apiError by statusTransport, so this holds as long
+// as the client library returns the error of its HTTP client without
+// formatting it into a new one.
+func isNotFound(err error) bool {
+
+	var apiErr *apiError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+func expandUsers(allUsers []interface{}) []cloudcenter.User {
+
+	var users []cloudcenter.User
+
+	for _, user := range allUsers {
+
+		u, _ := user.(map[string]interface{})
+
+		users = append(users, cloudcenter.User{
+			Id: u["user_id"].(string),
+		})
+	}
+
+	return users
+}
+
+func expandGroups(allGroups []interface{}) []cloudcenter.Group {
+
+	var groups []cloudcenter.Group
+
+	for _, group := range allGroups {
+
+		g, _ := group.(map[string]interface{})
+
+		groups = append(groups, cloudcenter.Group{
+			Id: g["group_id"].(string),
+		})
+	}
+
+	return groups
+}
+
+func expandRoles(allRoles []interface{}) []cloudcenter.Role {
+
+	var roles []cloudcenter.Role
+
+	for _, role := range allRoles {
+
+		r, _ := role.(map[string]interface{})
+
+		roles = append(roles, cloudcenter.Role{
+			Id: r["role_id"].(string),
+		})
+	}
+
+	return roles
+}
+
+// expandStringList converts a list of strings from the configuration, such
+// as tag names or a set of IDs, into a string slice.
+func expandStringList(allStrings []interface{}) []string {
+
+	result := []string{}
+
+	for _, s := range allStrings {
+		result = append(result, s.(string))
+	}
+
+	return result
+}
+
+func flattenUsers(users []cloudcenter.User) []interface{} {
+
+	result := make([]interface{}, 0, len(users))
+
+	for _, user := range users {
+		result = append(result, map[string]interface{}{
+			"user_id": user.Id,
+		})
+	}
+
+	return result
+}
+
+func flattenGroups(groups []cloudcenter.Group) []interface{} {
+
+	result := make([]interface{}, 0, len(groups))
+
+	for _, group := range groups {
+		result = append(result, map[string]interface{}{
+			"group_id": group.Id,
+		})
+	}
+
+	return result
+}
+
+func flattenRoles(roles []cloudcenter.Role) []interface{} {
+
+	result := make([]interface{}, 0, len(roles))
+
+	for _, role := range roles {
+		result = append(result, map[string]interface{}{
+			"role_id": role.Id,
+		})
+	}
+
+	return result
+}
+
+func flattenObjectPerms(objectPerms []cloudcenter.ObjectPerm) []interface{} {
+
+	result := make([]interface{}, 0, len(objectPerms))
+
+	for _, objectPerm := range objectPerms {
+		result = append(result, map[string]interface{}{
+			"object_type": objectPerm.ObjectType,
+			"permissions": objectPerm.Perms,
+		})
+	}
+
+	return result
+}
+
+func flattenActivateRegions(regions []cloudcenter.ActivateRegion) []interface{} {
+
+	result := make([]interface{}, 0, len(regions))
+
+	for _, region := range regions {
+		result = append(result, map[string]interface{}{
+			"region_id": region.RegionId,
+		})
+	}
+
+	return result
+}
+
+// rawStateString renders a value from raw state in the form used within an
+// ID. Numbers such as a TypeInt tenant_id are decoded from the state JSON as
+// float64, which fmt.Sprint would render as 1e+06 for larger values.
+func rawStateString(v interface{}) string {
+
+	switch v := v.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case string:
+		return v
+	}
+
+	return ""
+}